@@ -0,0 +1,95 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/joho/godotenv"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	db "github.com/okanay/backend-blog-guideofdubai/database"
+	BlogRepository "github.com/okanay/backend-blog-guideofdubai/repositories/blog"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// Mevcut blog içeriklerini yeni kayıt kurallarına göre bir kez güncelleyen komut.
+// Kullanım: go run ./cmd/backfill <komut>
+func main() {
+	// .env dosyasını yükle
+	if err := godotenv.Load(); err != nil {
+		log.Printf("Uyarı: .env dosyası yüklenemedi: %v", err)
+	}
+
+	dbURL := os.Getenv("DATABASE_URL")
+	if dbURL == "" {
+		log.Fatal("HATA: DATABASE_URL environment değişkeni ayarlanmamış.")
+	}
+
+	flag.Parse()
+	args := flag.Args()
+	if len(args) == 0 {
+		log.Fatal("HATA: Komut belirtilmedi. Komutlar: sanitize-html, content-stats")
+	}
+
+	sqlDB, err := db.Init(dbURL)
+	if err != nil {
+		log.Fatalf("HATA: Veritabanına bağlanılamadı: %v", err)
+	}
+	defer sqlDB.Close()
+
+	repo := BlogRepository.NewRepository(sqlDB)
+
+	switch args[0] {
+	case "sanitize-html":
+		// Temizleyiciden önce kaydedilmiş HTML'ler izin listesinden geçirilir
+		runBackfill(repo, "sanitize-html", func(row types.BlogContentBackfillRow) (bool, error) {
+			html := utils.SanitizeHTML(row.HTML)
+			if html == row.HTML {
+				return false, nil
+			}
+			return true, repo.UpdateBlogContentHTML(row.ID, html)
+		})
+	case "content-stats":
+		// 000017 migration'ından önce kaydedilen içeriklerin kelime sayısı ve içindekiler tablosu hesaplanır;
+		// HTML, başlık anchor'ları içindekiler tablosuyla eşleşsin diye JSON'dan yeniden üretilir
+		runBackfill(repo, "content-stats", func(row types.BlogContentBackfillRow) (bool, error) {
+			return true, repo.UpdateBlogContentStats(row.ID, row.Language, row.JSON)
+		})
+	default:
+		log.Fatalf("HATA: Bilinmeyen komut: %s", args[0])
+	}
+}
+
+// runBackfill tüm içerikleri parçalar halinde dolaşır; bir satırdaki hata diğerlerini durdurmaz
+func runBackfill(repo *BlogRepository.Repository, name string, apply func(types.BlogContentBackfillRow) (bool, error)) {
+	var scanned, updated, failed int
+	afterID := uuid.Nil
+
+	for {
+		batch, err := repo.SelectBlogContentBatch(afterID, configs.CONTENT_BACKFILL_BATCH_SIZE)
+		if err != nil {
+			log.Fatalf("HATA: İçerikler okunamadı: %v", err)
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		for _, row := range batch {
+			scanned++
+			changed, err := apply(row)
+			switch {
+			case err != nil:
+				failed++
+				log.Printf("[BACKFILL]: %s başarısız (%s): %v", name, row.ID, err)
+			case changed:
+				updated++
+			}
+		}
+
+		afterID = batch[len(batch)-1].ID
+	}
+
+	log.Printf("[BACKFILL]: %s tamamlandı. Taranan: %d, güncellenen: %d, hatalı: %d", name, scanned, updated, failed)
+}
@@ -0,0 +1,31 @@
+package configs
+
+type APIScope string
+
+// API anahtarlarına verilebilecek yetki kapsamları.
+// Kapsam yalnızca anahtarın neye erişebileceğini daraltır; rol izinleri ayrıca uygulanır.
+const (
+	ScopeBlogRead   APIScope = "blog:read"
+	ScopeBlogWrite  APIScope = "blog:write"
+	ScopeImageRead  APIScope = "image:read"
+	ScopeImageWrite APIScope = "image:write"
+	ScopeAIUse      APIScope = "ai:use"
+)
+
+var APIScopes = []APIScope{
+	ScopeBlogRead,
+	ScopeBlogWrite,
+	ScopeImageRead,
+	ScopeImageWrite,
+	ScopeAIUse,
+}
+
+// IsValidAPIScope verilen değerin tanımlı bir kapsam olup olmadığını kontrol eder
+func IsValidAPIScope(scope string) bool {
+	for _, s := range APIScopes {
+		if string(s) == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -11,6 +11,48 @@ const (
 	// STATS RULES
 	VIEW_CACHE_EXPIRATION = 1 * time.Minute
 
+	// SCHEDULER RULES
+	SCHEDULER_INTERVAL = 1 * time.Minute
+
+	// TRASH RULES (TRASH_RETENTION_DAYS env değişkeni ile değiştirilebilir)
+	TRASH_RETENTION_DAYS  = 30
+	TRASH_PURGE_INTERVAL  = 1 * time.Hour
+	TRASH_PURGE_BATCH_MAX = 50
+
+	// SITEMAP RULES (bloglar cursor ile bu boyutta parçalar halinde okunur)
+	SITEMAP_BATCH_SIZE = 500
+
+	// CONTENT BACKFILL RULES (mevcut içerikler id sırasıyla bu boyutta parçalar halinde güncellenir)
+	CONTENT_BACKFILL_BATCH_SIZE = 200
+
+	// BLOG BULK RULES
+	BLOG_BULK_MAX_ITEMS = 500
+
+	// BLOG SEARCH RULES
+	BLOG_SEARCH_MIN_QUERY_LENGTH = 2
+	BLOG_SEARCH_DEFAULT_LIMIT    = 10
+	BLOG_SEARCH_MAX_LIMIT        = 50
+	BLOG_SEARCH_TRGM_THRESHOLD   = 0.4 // Yazım hatası toleransı için pg_trgm word_similarity eşiği
+
+	// SEARCH ANALYTICS RULES
+	SEARCH_ANALYTICS_BUFFER_SIZE     = 1000 // Dolu olduğunda yeni kayıtlar atılır, istek beklemez
+	SEARCH_ANALYTICS_BATCH_SIZE      = 100
+	SEARCH_ANALYTICS_FLUSH_INTERVAL  = 5 * time.Second
+	SEARCH_ANALYTICS_QUERY_MAX_RUNES = 200
+	SEARCH_ANALYTICS_DEFAULT_WINDOW  = 7 * 24 * time.Hour
+	SEARCH_ANALYTICS_MAX_LIMIT       = 200
+	SEARCH_ANALYTICS_TRENDING_MIN    = 3 // Trend sayılması için aralıktaki en az arama sayısı
+
+	// Herkese açık tıklama takibinin paylaşılan kuyruğu doldurmaması için IP başına sınır
+	SEARCH_CLICK_RATE_LIMIT  = 30
+	SEARCH_CLICK_RATE_WINDOW = 1 * time.Minute
+
+	// Kapanışta süren isteklerin tamamlanması için beklenen en uzun süre
+	SERVER_SHUTDOWN_TIMEOUT = 15 * time.Second
+
+	// BLOG CONTENT RULES (editör JSON'ının bayt cinsinden üst sınırı)
+	BLOG_CONTENT_MAX_BYTES = 1 << 20
+
 	// AI RATE LIMIT RULES
 	AI_RATE_LIMIT_WINDOW         = 30 * time.Minute
 	AI_RATE_LIMIT_MAX_REQUESTS   = 50
@@ -24,4 +66,41 @@ const (
 	ACCESS_TOKEN_NAME      = "guideofdubai_blog_access_token"
 	ACCESS_TOKEN_DURATION  = 1 * time.Minute
 	JWT_ISSUER             = "guideofdubai-blog"
+
+	// Paralel isteklerin aynı (rotate edilmiş) token ile yenileme yapabileceği süre
+	REFRESH_TOKEN_REUSE_GRACE = 30 * time.Second
+
+	// Rotate/iptal edilmiş tokenların tekrar kullanım tespiti için saklandığı süre ve temizlik aralığı
+	REFRESH_TOKEN_RETENTION        = 7 * 24 * time.Hour
+	REFRESH_TOKEN_CLEANUP_INTERVAL = 1 * time.Hour
+
+	// Login Brute-Force Rules
+	LOGIN_ATTEMPT_WINDOW            = 15 * time.Minute
+	LOGIN_MAX_FAILURES_PER_USERNAME = 5
+	LOGIN_MAX_FAILURES_PER_IP       = 20
+	LOGIN_LOCKOUT_BASE_DURATION     = 30 * time.Second
+	LOGIN_LOCKOUT_MAX_DURATION      = 15 * time.Minute
+
+	// Two Factor Rules
+	TWO_FACTOR_ISSUER              = "Guide Of Dubai Blog"
+	TWO_FACTOR_CHALLENGE_DURATION  = 5 * time.Minute
+	TWO_FACTOR_CHALLENGE_LENGTH    = 48
+	TWO_FACTOR_MAX_ATTEMPTS        = 5
+	TWO_FACTOR_RECOVERY_CODE_COUNT = 10
+
+	// API Key Rules
+	API_KEY_PREFIX           = "gob_"
+	API_KEY_LENGTH           = 40
+	API_KEY_MAX_PER_USER     = 20
+	API_KEY_LAST_USED_WINDOW = 1 * time.Minute
+
+	// Password Reset Rules
+	PASSWORD_RESET_TOKEN_LENGTH   = 48
+	PASSWORD_RESET_TOKEN_DURATION = 30 * time.Minute
+
+	// Email Verification Rules
+	EMAIL_VERIFICATION_TOKEN_LENGTH     = 48
+	EMAIL_VERIFICATION_TOKEN_DURATION   = 24 * time.Hour
+	EMAIL_VERIFICATION_RESEND_COOLDOWN  = 2 * time.Minute
+	EMAIL_VERIFICATION_RESEND_DAILY_MAX = 5
 )
@@ -8,9 +8,13 @@ type Permission string
 type Access string
 
 const (
-	CreatePost Permission = "create-post"
-	EditPost   Permission = "edit-post"
-	DeletePost Permission = "delete-post"
+	CreatePost     Permission = "create-post"
+	EditPost       Permission = "edit-post"
+	DeletePost     Permission = "delete-post"
+	ManageTaxonomy Permission = "manage-taxonomy" // Etiket ve kategori oluşturma
+	ManageFeatured Permission = "manage-featured"
+	UploadImage    Permission = "upload-image"
+	DeleteImage    Permission = "delete-image"
 )
 
 const (
@@ -21,39 +25,52 @@ const (
 
 var RolePermissionConfig = map[types.Role]map[Permission]Access{
 	types.RoleEditor: {
-		CreatePost: AccessFull,
-		EditPost:   AccessFull,
-		DeletePost: AccessNone,
+		CreatePost:     AccessFull,
+		EditPost:       AccessFull,
+		DeletePost:     AccessNone,
+		ManageTaxonomy: AccessFull,
+		ManageFeatured: AccessFull,
+		UploadImage:    AccessFull,
+		DeleteImage:    AccessOwn,
 	},
 	types.RoleUser: {
-		CreatePost: AccessNone,
-		EditPost:   AccessNone,
-		DeletePost: AccessNone,
+		CreatePost:     AccessNone,
+		EditPost:       AccessNone,
+		DeletePost:     AccessNone,
+		ManageTaxonomy: AccessNone,
+		ManageFeatured: AccessNone,
+		UploadImage:    AccessNone,
+		DeleteImage:    AccessNone,
 	},
 }
 
-func CheckPermission(role types.Role, permission Permission, userID string, resourceOwnerID string) bool {
+// GetAccess bir rolün verilen izin için erişim seviyesini döndürür
+func GetAccess(role types.Role, permission Permission) Access {
 	if role == types.RoleAdmin {
-		return true
+		return AccessFull
 	}
 
 	permConfig, exists := RolePermissionConfig[role]
 	if !exists {
-		return false
+		return AccessNone
 	}
 
 	accessType, exists := permConfig[permission]
 	if !exists {
-		return false
+		return AccessNone
 	}
 
-	switch accessType {
+	return accessType
+}
+
+// CheckPermission rolün izni kaynak üzerinde kullanıp kullanamayacağını döndürür;
+// AccessOwn yalnızca kaynağın sahibi için geçerlidir
+func CheckPermission(role types.Role, permission Permission, userID string, resourceOwnerID string) bool {
+	switch GetAccess(role, permission) {
 	case AccessFull:
 		return true
 	case AccessOwn:
 		return userID == resourceOwnerID
-	case AccessNone:
-		return false
 	default:
 		return false
 	}
@@ -0,0 +1,71 @@
+package configs
+
+import (
+	"testing"
+
+	"github.com/okanay/backend-blog-guideofdubai/types"
+)
+
+func TestGetAccess(t *testing.T) {
+	tests := []struct {
+		role       types.Role
+		permission Permission
+		want       Access
+	}{
+		{types.RoleAdmin, CreatePost, AccessFull},
+		{types.RoleAdmin, DeletePost, AccessFull},
+		{types.RoleAdmin, DeleteImage, AccessFull},
+		{types.RoleEditor, CreatePost, AccessFull},
+		{types.RoleEditor, EditPost, AccessFull},
+		{types.RoleEditor, DeletePost, AccessNone},
+		{types.RoleEditor, ManageTaxonomy, AccessFull},
+		{types.RoleEditor, ManageFeatured, AccessFull},
+		{types.RoleEditor, UploadImage, AccessFull},
+		{types.RoleEditor, DeleteImage, AccessOwn},
+		{types.RoleUser, CreatePost, AccessNone},
+		{types.RoleUser, EditPost, AccessNone},
+		{types.RoleUser, DeletePost, AccessNone},
+		{types.RoleUser, ManageTaxonomy, AccessNone},
+		{types.RoleUser, ManageFeatured, AccessNone},
+		{types.RoleUser, UploadImage, AccessNone},
+		{types.RoleUser, DeleteImage, AccessNone},
+		{types.Role("Unknown"), CreatePost, AccessNone},
+		{types.RoleEditor, Permission("unknown"), AccessNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role)+"/"+string(tt.permission), func(t *testing.T) {
+			if got := GetAccess(tt.role, tt.permission); got != tt.want {
+				t.Errorf("GetAccess(%q, %q) = %q, want %q", tt.role, tt.permission, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckPermission(t *testing.T) {
+	tests := []struct {
+		name       string
+		role       types.Role
+		permission Permission
+		userID     string
+		ownerID    string
+		want       bool
+	}{
+		{"admin any resource", types.RoleAdmin, DeletePost, "u1", "u2", true},
+		{"editor full access", types.RoleEditor, EditPost, "u1", "u2", true},
+		{"editor own image", types.RoleEditor, DeleteImage, "u1", "u1", true},
+		{"editor other's image", types.RoleEditor, DeleteImage, "u1", "u2", false},
+		{"editor no access", types.RoleEditor, DeletePost, "u1", "u1", false},
+		{"user no access", types.RoleUser, CreatePost, "u1", "u1", false},
+		{"unknown role", types.Role("Unknown"), CreatePost, "u1", "u1", false},
+		{"unknown permission", types.RoleEditor, Permission("unknown"), "u1", "u1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CheckPermission(tt.role, tt.permission, tt.userID, tt.ownerID); got != tt.want {
+				t.Errorf("CheckPermission() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_blog_revisions_blog_id;
+
+DROP TABLE IF EXISTS blog_revisions;
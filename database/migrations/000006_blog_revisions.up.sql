@@ -0,0 +1,36 @@
+-- BLOG REVISIONS TABLE
+-- Her oluşturma/güncelleme işleminde blogun son hali burada saklanır.
+CREATE TABLE IF NOT EXISTS blog_revisions (
+    id UUID DEFAULT uuid_generate_v4 () PRIMARY KEY,
+    blog_id UUID NOT NULL REFERENCES blog_posts (id) ON DELETE CASCADE,
+    revision_number INTEGER NOT NULL,
+    user_id UUID REFERENCES users (id) ON DELETE SET NULL,
+
+    -- Blog post snapshot
+    group_id TEXT NOT NULL,
+    slug TEXT NOT NULL,
+    language TEXT NOT NULL,
+    status blog_status NOT NULL,
+
+    -- Metadata snapshot
+    meta_title TEXT NOT NULL,
+    meta_description TEXT,
+    meta_image TEXT,
+
+    -- Content snapshot
+    content_title TEXT NOT NULL,
+    content_description TEXT,
+    content_image TEXT,
+    read_time INTEGER DEFAULT 0,
+    html TEXT NOT NULL,
+    json TEXT NOT NULL,
+
+    -- Relations snapshot (blog_categories.category_name / blog_tags.tag_name)
+    categories TEXT[] DEFAULT '{}' NOT NULL,
+    tags TEXT[] DEFAULT '{}' NOT NULL,
+
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL,
+    UNIQUE (blog_id, revision_number)
+);
+
+CREATE INDEX IF NOT EXISTS idx_blog_revisions_blog_id ON blog_revisions (blog_id, revision_number DESC);
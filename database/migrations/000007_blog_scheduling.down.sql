@@ -0,0 +1,10 @@
+-- PostgreSQL enum değerlerini kaldırmayı desteklemez, bu yüzden 'scheduled' değeri
+-- tipte kalır; zamanlanmış bloglar taslağa çekilir.
+UPDATE blog_posts SET status = 'draft' WHERE status = 'scheduled';
+
+UPDATE blog_revisions SET status = 'draft' WHERE status = 'scheduled';
+
+DROP INDEX IF EXISTS idx_blog_posts_scheduled_at;
+
+ALTER TABLE blog_posts
+DROP COLUMN IF EXISTS scheduled_at;
@@ -0,0 +1,9 @@
+-- Zamanlanmış yayın durumu
+ALTER TYPE blog_status ADD VALUE IF NOT EXISTS 'scheduled';
+
+-- Yayın zamanı (sadece 'scheduled' durumundaki bloglar için dolu olur)
+ALTER TABLE blog_posts ADD COLUMN IF NOT EXISTS scheduled_at TIMESTAMPTZ DEFAULT NULL;
+
+-- Yayın zamanı gelen blogları hızlıca bulmak için
+CREATE INDEX IF NOT EXISTS idx_blog_posts_scheduled_at ON blog_posts (scheduled_at)
+WHERE scheduled_at IS NOT NULL;
@@ -0,0 +1,10 @@
+-- BLOG DRAFTS TABLE
+-- Editörün otomatik kaydettiği çalışma kopyası. Canlı içerik (blog_content vb.)
+-- "publish changes" işlemine kadar değişmez. Her blog için tek bir taslak tutulur.
+CREATE TABLE IF NOT EXISTS blog_drafts (
+    blog_id UUID PRIMARY KEY REFERENCES blog_posts (id) ON DELETE CASCADE,
+    user_id UUID REFERENCES users (id) ON DELETE SET NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT NOW () NOT NULL
+);
@@ -0,0 +1,13 @@
+-- PASSWORD RESET TOKENS TABLE
+-- Token'ın kendisi değil SHA-256 hash'i saklanır. Tokenlar tek kullanımlıktır (used_at).
+CREATE TABLE IF NOT EXISTS password_reset_tokens (
+    id UUID DEFAULT uuid_generate_v4 () PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    token_hash TEXT UNIQUE NOT NULL,
+    ip_address TEXT,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ DEFAULT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_password_reset_tokens_user_id ON password_reset_tokens (user_id);
@@ -0,0 +1,16 @@
+-- EMAIL VERIFICATION TOKENS TABLE
+-- Token'ın kendisi değil SHA-256 hash'i saklanır. Yeniden gönderim limiti bu tablodan hesaplanır.
+CREATE TABLE IF NOT EXISTS email_verification_tokens (
+    id UUID DEFAULT uuid_generate_v4 () PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    token_hash TEXT UNIQUE NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ DEFAULT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_email_verification_tokens_user_id ON email_verification_tokens (user_id, created_at DESC);
+
+-- Doğrulama akışı öncesinde kayıt olmuş hesaplar doğrulanmış kabul edilir,
+-- aksi halde mevcut editörler blog oluşturma ve AI rotalarına erişemez.
+UPDATE users SET email_verified = TRUE WHERE email_verified IS DISTINCT FROM TRUE;
@@ -0,0 +1,15 @@
+DROP INDEX IF EXISTS idx_refresh_token_reuse_events_user_id;
+
+DROP TABLE IF EXISTS refresh_token_reuse_events;
+
+DROP TRIGGER IF EXISTS trigger_refresh_token_family ON refresh_tokens;
+
+DROP FUNCTION IF EXISTS set_refresh_token_family();
+
+DROP INDEX IF EXISTS idx_refresh_tokens_family_id;
+
+ALTER TABLE refresh_tokens
+    DROP COLUMN IF EXISTS replaced_by,
+    DROP COLUMN IF EXISTS rotated_at,
+    DROP COLUMN IF EXISTS parent_id,
+    DROP COLUMN IF EXISTS family_id;
@@ -0,0 +1,45 @@
+-- REFRESH TOKEN ROTATION
+-- Her yenilemede yeni bir token üretilir. Aynı oturumdan türeyen tokenlar bir "family" oluşturur.
+ALTER TABLE refresh_tokens
+    ADD COLUMN IF NOT EXISTS family_id UUID,
+    ADD COLUMN IF NOT EXISTS parent_id UUID REFERENCES refresh_tokens (id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS rotated_at TIMESTAMPTZ DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS replaced_by UUID REFERENCES refresh_tokens (id) ON DELETE SET NULL;
+
+-- Mevcut tokenlar kendi family'lerinin ilk halkasıdır
+UPDATE refresh_tokens SET family_id = id WHERE family_id IS NULL;
+
+ALTER TABLE refresh_tokens ALTER COLUMN family_id SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family_id ON refresh_tokens (family_id);
+
+-- Yeni oturumlarda family_id verilmezse token kendi family'sini başlatır
+CREATE OR REPLACE FUNCTION set_refresh_token_family()
+RETURNS TRIGGER AS $$
+BEGIN
+  IF NEW.family_id IS NULL THEN
+    NEW.family_id = NEW.id;
+  END IF;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_refresh_token_family
+BEFORE INSERT ON refresh_tokens
+FOR EACH ROW
+EXECUTE FUNCTION set_refresh_token_family();
+
+-- REFRESH TOKEN REUSE EVENTS
+-- Daha önce rotate edilmiş bir token tekrar kullanıldığında kayıt düşülür ve family iptal edilir.
+CREATE TABLE IF NOT EXISTS refresh_token_reuse_events (
+    id UUID DEFAULT uuid_generate_v4 () PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    family_id UUID NOT NULL,
+    token_id UUID NOT NULL,
+    ip_address TEXT,
+    user_agent TEXT,
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_refresh_token_reuse_events_user_id ON refresh_token_reuse_events (user_id, created_at DESC);
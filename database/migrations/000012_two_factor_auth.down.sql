@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS two_factor_policies;
+
+DROP INDEX IF EXISTS idx_user_recovery_codes_user_id;
+
+DROP TABLE IF EXISTS user_recovery_codes;
+
+DROP TABLE IF EXISTS user_two_factor;
@@ -0,0 +1,36 @@
+-- USER TWO FACTOR TABLE
+-- Kurulum başlatıldığında enabled = FALSE olarak oluşturulur, ilk doğru kod ile onaylanır.
+-- last_used_step aynı kodun pencere içinde ikinci kez kullanılmasını engeller.
+CREATE TABLE IF NOT EXISTS user_two_factor (
+    user_id UUID PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    secret TEXT NOT NULL,
+    enabled BOOLEAN DEFAULT FALSE NOT NULL,
+    confirmed_at TIMESTAMPTZ DEFAULT NULL,
+    last_used_step BIGINT DEFAULT 0 NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT NOW () NOT NULL
+);
+
+-- RECOVERY CODES TABLE
+-- Kodların kendisi değil SHA-256 hash'i saklanır, her kod tek kullanımlıktır.
+CREATE TABLE IF NOT EXISTS user_recovery_codes (
+    id UUID DEFAULT uuid_generate_v4 () PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    code_hash TEXT NOT NULL,
+    used_at TIMESTAMPTZ DEFAULT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_recovery_codes_user_id ON user_recovery_codes (user_id);
+
+-- TWO FACTOR ROLE POLICIES
+CREATE TABLE IF NOT EXISTS two_factor_policies (
+    role role PRIMARY KEY,
+    required BOOLEAN DEFAULT FALSE NOT NULL,
+    updated_by UUID REFERENCES users (id) ON DELETE SET NULL,
+    updated_at TIMESTAMPTZ DEFAULT NOW () NOT NULL
+);
+
+INSERT INTO two_factor_policies (role, required)
+VALUES ('User', FALSE), ('Editor', FALSE), ('Admin', FALSE)
+ON CONFLICT (role) DO NOTHING;
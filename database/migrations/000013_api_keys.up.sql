@@ -0,0 +1,17 @@
+-- API KEYS TABLE
+-- Anahtarın kendisi değil SHA-256 hash'i saklanır; prefix yalnızca listelemede anahtarı tanımak içindir.
+CREATE TABLE IF NOT EXISTS api_keys (
+    id UUID DEFAULT uuid_generate_v4 () PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    prefix TEXT NOT NULL,
+    key_hash TEXT UNIQUE NOT NULL,
+    scopes TEXT[] DEFAULT '{}' NOT NULL,
+    expires_at TIMESTAMPTZ DEFAULT NULL,
+    last_used_at TIMESTAMPTZ DEFAULT NULL,
+    last_used_ip TEXT DEFAULT NULL,
+    revoked_at TIMESTAMPTZ DEFAULT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_user_id ON api_keys (user_id, created_at DESC);
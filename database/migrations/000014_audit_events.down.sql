@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_audit_events_target;
+
+DROP INDEX IF EXISTS idx_audit_events_action;
+
+DROP INDEX IF EXISTS idx_audit_events_actor_id;
+
+DROP INDEX IF EXISTS idx_audit_events_created_at;
+
+DROP TABLE IF EXISTS audit_events;
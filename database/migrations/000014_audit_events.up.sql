@@ -0,0 +1,24 @@
+-- AUDIT EVENTS TABLE
+-- Kullanıcı silinse de kayıt korunur; actor_username o anki kullanıcı adını saklar.
+CREATE TABLE IF NOT EXISTS audit_events (
+    id UUID DEFAULT uuid_generate_v4 () PRIMARY KEY,
+    actor_id UUID REFERENCES users (id) ON DELETE SET NULL,
+    actor_username TEXT,
+    action TEXT NOT NULL,
+    target_type TEXT NOT NULL,
+    target_id TEXT,
+    before JSONB,
+    after JSONB,
+    ip_address TEXT,
+    user_agent TEXT,
+    auth_method TEXT,
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_created_at ON audit_events (created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor_id ON audit_events (actor_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_action ON audit_events (action, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_target ON audit_events (target_type, target_id, created_at DESC);
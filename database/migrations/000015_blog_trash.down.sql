@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_blog_posts_deleted_at;
+
+ALTER TABLE blog_posts DROP COLUMN IF EXISTS status_before_delete;
+
+ALTER TABLE blog_posts DROP COLUMN IF EXISTS deleted_by;
+
+ALTER TABLE blog_posts DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,14 @@
+-- BLOG TRASH
+-- Silinen bloglar "deleted" durumuna alınır; kim, ne zaman sildi ve silinmeden önceki durum saklanır.
+-- Geri yükleme status_before_delete değerine döner, saklama süresi dolanlar kalıcı olarak silinir.
+ALTER TABLE blog_posts ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ DEFAULT NULL;
+
+ALTER TABLE blog_posts ADD COLUMN IF NOT EXISTS deleted_by UUID REFERENCES users (id) ON DELETE SET NULL;
+
+ALTER TABLE blog_posts ADD COLUMN IF NOT EXISTS status_before_delete blog_status DEFAULT NULL;
+
+-- Daha önce silinmiş bloglar için silinme zamanı bilinmediğinden son güncelleme zamanı kullanılır
+UPDATE blog_posts SET deleted_at = updated_at WHERE status = 'deleted' AND deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_blog_posts_deleted_at ON blog_posts (deleted_at)
+WHERE status = 'deleted';
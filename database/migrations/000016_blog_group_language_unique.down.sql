@@ -0,0 +1 @@
+DROP INDEX IF EXISTS blog_posts_group_language_key;
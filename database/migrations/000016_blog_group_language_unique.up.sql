@@ -0,0 +1,14 @@
+-- TRANSLATION GROUPS
+-- Bir çeviri grubunda her dil için en fazla bir blog olabilir (çöp kutusundakiler hariç).
+-- Mevcut çakışmalarda en eski blog grupta kalır, diğerleri kendi ID'leriyle türetilen yeni bir gruba taşınır.
+UPDATE blog_posts bp
+SET group_id = bp.group_id || '-' || LEFT(bp.id::text, 8)
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY group_id, language ORDER BY created_at, id) AS rn
+    FROM blog_posts
+    WHERE status != 'deleted'
+) dup
+WHERE bp.id = dup.id AND dup.rn > 1;
+
+CREATE UNIQUE INDEX IF NOT EXISTS blog_posts_group_language_key ON blog_posts (group_id, language)
+WHERE status != 'deleted';
@@ -0,0 +1,4 @@
+ALTER TABLE blog_content
+    DROP COLUMN IF EXISTS toc,
+    DROP COLUMN IF EXISTS read_time_override,
+    DROP COLUMN IF EXISTS word_count;
@@ -0,0 +1,8 @@
+-- CONTENT STATS
+-- Kelime sayısı, içindekiler tablosu ve okuma süresi kaydedilirken içerik JSON'ından hesaplanır.
+-- read_time_override true ise elle girilen okuma süresi korunur.
+-- Mevcut yazılar için: make backfill-content-stats
+ALTER TABLE blog_content
+    ADD COLUMN IF NOT EXISTS word_count INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS read_time_override BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS toc JSONB NOT NULL DEFAULT '[]'::jsonb;
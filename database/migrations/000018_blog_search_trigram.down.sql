@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS blog_content_description_trgm_idx;
+DROP INDEX IF EXISTS blog_content_title_trgm_idx;
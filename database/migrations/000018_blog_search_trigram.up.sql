@@ -0,0 +1,6 @@
+-- BLOG SEARCH
+-- Yazım hatalarına toleranslı arama için trigram eşleştirmesi
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS blog_content_title_trgm_idx ON blog_content USING gin (title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS blog_content_description_trgm_idx ON blog_content USING gin (description gin_trgm_ops);
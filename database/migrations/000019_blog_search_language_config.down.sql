@@ -0,0 +1,28 @@
+DROP TRIGGER IF EXISTS blog_posts_language_search_refresh ON blog_posts;
+DROP FUNCTION IF EXISTS refresh_blog_content_search_vector ();
+
+-- 000005'teki varsayılan yapılandırmalı fonksiyona geri dön
+CREATE OR REPLACE FUNCTION update_blog_search_vectors() RETURNS TRIGGER AS $$
+BEGIN
+  IF TG_TABLE_NAME = 'blog_posts' THEN
+    NEW.search_vector = setweight(to_tsvector(COALESCE(NEW.slug, '')), 'A');
+
+  ELSIF TG_TABLE_NAME = 'blog_content' THEN
+    NEW.search_vector =
+      setweight(to_tsvector(COALESCE(NEW.title, '')), 'A') ||
+      setweight(to_tsvector(COALESCE(NEW.description, '')), 'B');
+  END IF;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+UPDATE blog_posts SET search_vector = setweight(to_tsvector(COALESCE(slug, '')), 'A');
+
+UPDATE blog_content SET
+  search_vector =
+    setweight(to_tsvector(COALESCE(title, '')), 'A') ||
+    setweight(to_tsvector(COALESCE(description, '')), 'B');
+
+DROP FUNCTION IF EXISTS blog_search_plain_text (TEXT);
+DROP FUNCTION IF EXISTS blog_search_config (TEXT);
@@ -0,0 +1,73 @@
+-- LANGUAGE-AWARE SEARCH
+-- search_vector blogun diline göre seçilen metin arama yapılandırmasıyla (stemming) üretilir.
+-- Desteklenmeyen diller için 'simple' (stemming yok) kullanılır.
+CREATE OR REPLACE FUNCTION blog_search_config(lang TEXT) RETURNS regconfig AS $$
+  SELECT CASE split_part(lower(COALESCE(lang, '')), '-', 1)
+    WHEN 'en' THEN 'english'
+    WHEN 'tr' THEN 'turkish'
+    WHEN 'de' THEN 'german'
+    WHEN 'ru' THEN 'russian'
+    WHEN 'fr' THEN 'french'
+    WHEN 'es' THEN 'spanish'
+    WHEN 'it' THEN 'italian'
+    WHEN 'pt' THEN 'portuguese'
+    WHEN 'nl' THEN 'dutch'
+    WHEN 'ar' THEN 'arabic'
+    ELSE 'simple'
+  END::regconfig;
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE;
+
+-- blog_content.html'den etiketleri ve HTML entity'lerini ayıklayıp düz metin döndürür
+CREATE OR REPLACE FUNCTION blog_search_plain_text(content TEXT) RETURNS TEXT AS $$
+  SELECT regexp_replace(regexp_replace(COALESCE(content, ''), '<[^>]*>', ' ', 'g'), '&[#a-zA-Z0-9]+;', ' ', 'g');
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE;
+
+CREATE OR REPLACE FUNCTION update_blog_search_vectors() RETURNS TRIGGER AS $$
+DECLARE
+  config regconfig;
+BEGIN
+  IF TG_TABLE_NAME = 'blog_posts' THEN
+    NEW.search_vector = setweight(to_tsvector(blog_search_config(NEW.language), COALESCE(NEW.slug, '')), 'A');
+
+  -- İçerik satırının dili blog_posts'tan okunur
+  ELSIF TG_TABLE_NAME = 'blog_content' THEN
+    SELECT blog_search_config(bp.language) INTO config FROM blog_posts bp WHERE bp.id = NEW.id;
+    config = COALESCE(config, 'simple'::regconfig);
+
+    NEW.search_vector =
+      setweight(to_tsvector(config, COALESCE(NEW.title, '')), 'A') ||
+      setweight(to_tsvector(config, COALESCE(NEW.description, '')), 'B') ||
+      setweight(to_tsvector(config, blog_search_plain_text(NEW.html)), 'C');
+  END IF;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Blogun dili değiştiğinde içerik vektörü de yeni dile göre yeniden üretilir
+CREATE OR REPLACE FUNCTION refresh_blog_content_search_vector() RETURNS TRIGGER AS $$
+BEGIN
+  IF NEW.language IS DISTINCT FROM OLD.language THEN
+    UPDATE blog_content SET search_vector = NULL WHERE id = NEW.id;
+  END IF;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS blog_posts_language_search_refresh ON blog_posts;
+CREATE TRIGGER blog_posts_language_search_refresh
+AFTER UPDATE OF language ON blog_posts
+FOR EACH ROW EXECUTE FUNCTION refresh_blog_content_search_vector();
+
+-- Mevcut verileri yeni yapılandırmalarla güncelle
+UPDATE blog_posts SET
+  search_vector = setweight(to_tsvector(blog_search_config(language), COALESCE(slug, '')), 'A');
+
+UPDATE blog_content bc SET
+  search_vector =
+    setweight(to_tsvector(blog_search_config(bp.language), COALESCE(bc.title, '')), 'A') ||
+    setweight(to_tsvector(blog_search_config(bp.language), COALESCE(bc.description, '')), 'B') ||
+    setweight(to_tsvector(blog_search_config(bp.language), blog_search_plain_text(bc.html)), 'C')
+FROM blog_posts bp
+WHERE bp.id = bc.id;
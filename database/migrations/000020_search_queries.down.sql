@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_search_queries_query;
+
+DROP INDEX IF EXISTS idx_search_queries_created_at;
+
+DROP TABLE IF EXISTS search_queries;
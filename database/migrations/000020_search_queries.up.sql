@@ -0,0 +1,17 @@
+-- SEARCH QUERIES TABLE
+-- Ziyaretçi aramaları normalize edilmiş sorgu, dil ve sonuç sayısıyla kaydedilir.
+-- Arama sonucundaki bir bloga tıklandığında clicked_blog_id ve clicked_at doldurulur.
+CREATE TABLE IF NOT EXISTS search_queries (
+    id UUID PRIMARY KEY,
+    query TEXT NOT NULL,
+    language TEXT,
+    source TEXT NOT NULL,
+    result_count INTEGER NOT NULL DEFAULT 0,
+    clicked_blog_id UUID,
+    clicked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_search_queries_created_at ON search_queries (created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_search_queries_query ON search_queries (query, created_at DESC);
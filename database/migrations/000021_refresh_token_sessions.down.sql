@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_refresh_tokens_revoked_last_used;
+
+ALTER TABLE refresh_tokens
+    DROP COLUMN IF EXISTS session_started_at;
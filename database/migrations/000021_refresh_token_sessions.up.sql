@@ -0,0 +1,20 @@
+-- REFRESH TOKEN SESSIONS
+-- Oturumlar family_id ile tanımlanır; her rotasyon yeni bir satır oluşturduğu için oturumun
+-- başlangıç zamanı family içindeki tüm tokenlara taşınır.
+ALTER TABLE refresh_tokens
+    ADD COLUMN IF NOT EXISTS session_started_at TIMESTAMPTZ;
+
+UPDATE refresh_tokens rt SET session_started_at = f.started_at
+FROM (
+    SELECT family_id, MIN(created_at) AS started_at
+    FROM refresh_tokens
+    GROUP BY family_id
+) f
+WHERE rt.family_id = f.family_id AND rt.session_started_at IS NULL;
+
+ALTER TABLE refresh_tokens
+    ALTER COLUMN session_started_at SET DEFAULT NOW (),
+    ALTER COLUMN session_started_at SET NOT NULL;
+
+-- Rotate edilmiş ve iptal edilmiş satırların temizliği için
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_revoked_last_used ON refresh_tokens (last_used_at) WHERE is_revoked = TRUE;
@@ -0,0 +1,82 @@
+// handlers/admin/audit-events.go
+package AdminHandler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// GetAuditEvents audit kayıtlarını filtrelerle listeler
+// (?actorId=&action=&targetType=&targetId=&from=&to=&limit=&offset=). "blog." gibi nokta ile biten action ön ek olarak aranır.
+func (h *Handler) GetAuditEvents(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	options := types.AuditQueryOptions{
+		Action:     c.Query("action"),
+		TargetType: c.Query("targetType"),
+		TargetID:   c.Query("targetId"),
+		Limit:      limit,
+		Offset:     offset,
+	}
+
+	if value := c.Query("actorId"); value != "" {
+		actorID, err := uuid.Parse(value)
+		if err != nil {
+			utils.BadRequest(c, "Geçersiz actorId formatı.")
+			return
+		}
+		options.ActorID = &actorID
+	}
+
+	var ok bool
+	if options.From, ok = parseAuditTimeQuery(c, "from"); !ok {
+		return
+	}
+	if options.To, ok = parseAuditTimeQuery(c, "to"); !ok {
+		return
+	}
+
+	events, total, err := h.Audit.AuditRepo.SelectAuditEvents(options)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Audit kaydı listeleme")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"events":  events,
+		"total":   total,
+		"limit":   limit,
+		"offset":  offset,
+	})
+}
+
+// parseAuditTimeQuery RFC3339 formatındaki tarih filtresini okur; boşsa nil döner
+func parseAuditTimeQuery(c *gin.Context, key string) (*time.Time, bool) {
+	value := c.Query(key)
+	if value == "" {
+		return nil, true
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz "+key+" tarihi. RFC3339 formatı bekleniyor (ör. 2024-01-02T15:04:05Z).")
+		return nil, false
+	}
+
+	return &parsed, true
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/okanay/backend-blog-guideofdubai/configs"
+	"github.com/okanay/backend-blog-guideofdubai/services/cache"
 	"github.com/okanay/backend-blog-guideofdubai/types"
 )
 
@@ -25,8 +26,8 @@ func (h *Handler) GetCacheStats(c *gin.Context) {
 
 // ClearAllCache belirli önekleri koruyarak tüm cache'i temizler
 func (h *Handler) ClearAllCache(c *gin.Context) {
-	// Korunacak önekleri al (varsayılan olarak AI rate limitleri korunur)
-	protectedPrefixes := []string{"ai_rate_limit:", "ai_rate_limit_minute:"}
+	// Korunacak önekleri al (varsayılan olarak AI rate limitleri ve oturum kayıtları korunur)
+	protectedPrefixes := append([]string{}, cache.ProtectedPrefixes...)
 
 	// URL parametresi ile ek önekler belirtilebilir
 	if additionalProtected := c.Query("protect"); additionalProtected != "" {
@@ -37,6 +38,7 @@ func (h *Handler) ClearAllCache(c *gin.Context) {
 
 	// Blog cache'ini temizle, korunan önekleri atla
 	h.Cache.ClearExceptPrefixes(protectedPrefixes)
+	h.Audit.Record(c, types.AuditCacheClear, types.AuditTargetCache, "", nil, gin.H{"protectedPrefixes": protectedPrefixes})
 
 	c.JSON(http.StatusOK, gin.H{
 		"success":            true,
@@ -59,6 +61,7 @@ func (h *Handler) ClearCacheWithPrefix(c *gin.Context) {
 
 	// Belirtilen önekle başlayan tüm cache anahtarlarını temizle
 	h.Cache.ClearPrefix(prefix)
+	h.Audit.Record(c, types.AuditCacheClearPrefix, types.AuditTargetCache, prefix, nil, nil)
 
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
@@ -116,6 +119,7 @@ func (h *Handler) GetCacheItems(c *gin.Context) {
 func (h *Handler) ClearAIRateLimits(c *gin.Context) {
 	// Sadece AI rate limit cache'lerini temizle
 	h.Cache.ClearAIRateLimits()
+	h.Audit.Record(c, types.AuditRateLimitsClear, types.AuditTargetCache, "ai_rate_limit:", nil, nil)
 
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
@@ -188,8 +192,17 @@ func (h *Handler) ResetUserRateLimit(c *gin.Context) {
 	rateKey := fmt.Sprintf("ai_rate_limit:%s", userID)
 	minuteKey := fmt.Sprintf("ai_rate_limit_minute:%s", userID)
 
+	var before any
+	if data, exists := h.Cache.Get(rateKey); exists {
+		var rateInfo types.RateLimitInfo
+		if err := json.Unmarshal(data, &rateInfo); err == nil {
+			before = rateInfo
+		}
+	}
+
 	h.Cache.Delete(rateKey)
 	h.Cache.Delete(minuteKey)
+	h.Audit.Record(c, types.AuditRateLimitReset, types.AuditTargetUser, userID, before, nil)
 
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
@@ -3,19 +3,35 @@ package AdminHandler
 
 import (
 	BlogRepository "github.com/okanay/backend-blog-guideofdubai/repositories/blog"
+	TokenRepository "github.com/okanay/backend-blog-guideofdubai/repositories/token"
+	UserRepository "github.com/okanay/backend-blog-guideofdubai/repositories/user"
+	AnalyticsService "github.com/okanay/backend-blog-guideofdubai/services/analytics"
+	AuditService "github.com/okanay/backend-blog-guideofdubai/services/audit"
 	"github.com/okanay/backend-blog-guideofdubai/services/cache"
 )
 
 type Handler struct {
-	BlogRepository *BlogRepository.Repository
-	Cache          *cache.Cache
-	BlogCache      *cache.BlogCacheService
+	BlogRepository  *BlogRepository.Repository
+	UserRepository  *UserRepository.Repository
+	TokenRepository *TokenRepository.Repository
+	Cache           *cache.Cache
+	BlogCache       *cache.BlogCacheService
+	SessionCache    *cache.SessionCacheService
+	LoginAttempts   *cache.LoginAttemptCacheService
+	Audit           *AuditService.AuditService
+	Analytics       *AnalyticsService.AnalyticsService
 }
 
-func NewHandler(b *BlogRepository.Repository, c *cache.Cache) *Handler {
+func NewHandler(b *BlogRepository.Repository, u *UserRepository.Repository, t *TokenRepository.Repository, c *cache.Cache, a *AuditService.AuditService, an *AnalyticsService.AnalyticsService) *Handler {
 	return &Handler{
-		BlogRepository: b,
-		Cache:          c,
-		BlogCache:      cache.NewBlogCacheService(c),
+		BlogRepository:  b,
+		UserRepository:  u,
+		TokenRepository: t,
+		Cache:           c,
+		BlogCache:       cache.NewBlogCacheService(c),
+		SessionCache:    cache.NewSessionCacheService(c),
+		LoginAttempts:   cache.NewLoginAttemptCacheService(c),
+		Audit:           a,
+		Analytics:       an,
 	}
 }
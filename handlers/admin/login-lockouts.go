@@ -0,0 +1,96 @@
+package AdminHandler
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// GetLoginLockouts başarısız giriş kayıtlarını ve aktif kilitleri listeler (?locked=true yalnızca kilitliler)
+func (h *Handler) GetLoginLockouts(c *gin.Context) {
+	onlyLocked := c.Query("locked") == "true"
+	now := time.Now()
+
+	attempts := []gin.H{}
+	records := h.LoginAttempts.GetAll()
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].LastFailure.After(records[j].LastFailure)
+	})
+
+	for _, info := range records {
+		locked := info.IsLocked(now)
+		if onlyLocked && !locked {
+			continue
+		}
+
+		remaining := time.Duration(0)
+		if locked {
+			remaining = info.LockedUntil.Sub(now)
+		}
+
+		attempts = append(attempts, gin.H{
+			"scope":        info.Scope,
+			"identifier":   info.Identifier,
+			"failures":     info.Failures,
+			"firstFailure": info.FirstFailure,
+			"lastFailure":  info.LastFailure,
+			"locked":       locked,
+			"lockedUntil":  info.LockedUntil,
+			"remaining": gin.H{
+				"timeSeconds": int(remaining.Seconds()),
+				"timeHuman":   formatDuration(remaining),
+			},
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    attempts,
+		"count":   len(attempts),
+		"limits": gin.H{
+			"window":              configs.LOGIN_ATTEMPT_WINDOW.String(),
+			"maxFailuresPerUser":  configs.LOGIN_MAX_FAILURES_PER_USERNAME,
+			"maxFailuresPerIP":    configs.LOGIN_MAX_FAILURES_PER_IP,
+			"lockoutBaseDuration": configs.LOGIN_LOCKOUT_BASE_DURATION.String(),
+			"lockoutMaxDuration":  configs.LOGIN_LOCKOUT_MAX_DURATION.String(),
+		},
+	})
+}
+
+// ClearLoginLockout belirli bir kullanıcı adı veya IP için kilidi ve sayacı sıfırlar
+func (h *Handler) ClearLoginLockout(c *gin.Context) {
+	scope := types.LoginAttemptScope(c.Param("scope"))
+	if scope != types.LoginAttemptScopeUsername && scope != types.LoginAttemptScopeIP {
+		utils.BadRequest(c, "Geçersiz kapsam. 'username' veya 'ip' olmalıdır.")
+		return
+	}
+
+	identifier := c.Param("identifier")
+	if !h.LoginAttempts.Clear(scope, identifier) {
+		utils.NotFound(c, "Giriş kilidi")
+		return
+	}
+
+	h.Audit.Record(c, types.AuditLoginLockoutClear, types.AuditTargetLockout, string(scope)+":"+identifier, nil, nil)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Giriş kilidi başarıyla kaldırıldı.",
+	})
+}
+
+// ClearAllLoginLockouts tüm başarısız giriş kayıtlarını ve kilitleri temizler
+func (h *Handler) ClearAllLoginLockouts(c *gin.Context) {
+	h.LoginAttempts.ClearAll()
+	h.Audit.Record(c, types.AuditLoginLockoutClear, types.AuditTargetLockout, "", nil, nil)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Tüm giriş kilitleri temizlendi.",
+	})
+}
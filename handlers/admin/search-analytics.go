@@ -0,0 +1,108 @@
+package AdminHandler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// GetTopSearchQueries en çok aranan sorguları listeler (?from=&to=&language=&limit=)
+func (h *Handler) GetTopSearchQueries(c *gin.Context) {
+	options, ok := parseSearchAnalyticsOptions(c)
+	if !ok {
+		return
+	}
+
+	queries, err := h.Analytics.Repo.SelectTopSearchQueries(options)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Arama raporu")
+		return
+	}
+
+	sendSearchAnalytics(c, options, queries)
+}
+
+// GetZeroResultSearchQueries sonuç bulunamayan sorguları listeler (?from=&to=&language=&limit=)
+func (h *Handler) GetZeroResultSearchQueries(c *gin.Context) {
+	options, ok := parseSearchAnalyticsOptions(c)
+	if !ok {
+		return
+	}
+
+	queries, err := h.Analytics.Repo.SelectZeroResultSearchQueries(options)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Sonuçsuz arama raporu")
+		return
+	}
+
+	sendSearchAnalytics(c, options, queries)
+}
+
+// GetTrendingSearchQueries bir önceki eşit uzunluktaki aralığa göre aramaları artan sorguları listeler
+// (?from=&to=&language=&limit=)
+func (h *Handler) GetTrendingSearchQueries(c *gin.Context) {
+	options, ok := parseSearchAnalyticsOptions(c)
+	if !ok {
+		return
+	}
+
+	queries, err := h.Analytics.Repo.SelectTrendingSearchQueries(options)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Trend arama raporu")
+		return
+	}
+
+	sendSearchAnalytics(c, options, queries)
+}
+
+// parseSearchAnalyticsOptions zaman aralığını okur; verilmezse son SEARCH_ANALYTICS_DEFAULT_WINDOW kullanılır
+func parseSearchAnalyticsOptions(c *gin.Context) (types.SearchAnalyticsOptions, bool) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if limit <= 0 || limit > configs.SEARCH_ANALYTICS_MAX_LIMIT {
+		limit = 50
+	}
+
+	options := types.SearchAnalyticsOptions{
+		Language: c.Query("language"),
+		Limit:    limit,
+	}
+
+	from, ok := parseAuditTimeQuery(c, "from")
+	if !ok {
+		return options, false
+	}
+	to, ok := parseAuditTimeQuery(c, "to")
+	if !ok {
+		return options, false
+	}
+
+	options.To = time.Now()
+	if to != nil {
+		options.To = *to
+	}
+	options.From = options.To.Add(-configs.SEARCH_ANALYTICS_DEFAULT_WINDOW)
+	if from != nil {
+		options.From = *from
+	}
+
+	if !options.From.Before(options.To) {
+		utils.BadRequest(c, "from tarihi to tarihinden önce olmalıdır.")
+		return options, false
+	}
+
+	return options, true
+}
+
+func sendSearchAnalytics(c *gin.Context, options types.SearchAnalyticsOptions, queries any) {
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"queries": queries,
+		"from":    options.From,
+		"to":      options.To,
+	})
+}
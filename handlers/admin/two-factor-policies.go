@@ -0,0 +1,88 @@
+package AdminHandler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// GetTwoFactorPolicies rol bazında 2FA zorunluluklarını listeler
+func (h *Handler) GetTwoFactorPolicies(c *gin.Context) {
+	policies, err := h.UserRepository.SelectTwoFactorPolicies()
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "2FA politikaları listeleme")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"policies": policies,
+	})
+}
+
+// UpdateTwoFactorPolicy bir rol için 2FA zorunluluğunu açar veya kapatır.
+// Zorunluluk bir sonraki girişte uygulanır; kurulumu olmayan kullanıcılar giriş sırasında kurulum yapar.
+func (h *Handler) UpdateTwoFactorPolicy(c *gin.Context) {
+	role := types.Role(c.Param("role"))
+	if role != types.RoleUser && role != types.RoleEditor && role != types.RoleAdmin {
+		utils.BadRequest(c, "Geçersiz rol. 'User', 'Editor' veya 'Admin' olmalıdır.")
+		return
+	}
+
+	var request types.TwoFactorPolicyUpdateInput
+	if err := utils.ValidateRequest(c, &request); err != nil {
+		return
+	}
+
+	before, _ := h.UserRepository.IsTwoFactorRequired(role)
+
+	adminID := c.MustGet("user_id").(uuid.UUID)
+	policy, err := h.UserRepository.UpdateTwoFactorPolicy(role, *request.Required, adminID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "2FA politikası güncelleme")
+		return
+	}
+
+	h.Audit.Record(c, types.AuditTwoFactorPolicySave, types.AuditTargetPolicy, string(role), gin.H{"required": before}, gin.H{"required": policy.Required})
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "2FA politikası güncellendi.",
+		"policy":  policy,
+	})
+}
+
+// ResetUserTwoFactor cihazını kaybeden kullanıcının 2FA kaydını ve kurtarma kodlarını siler, açık oturumlarını sonlandırır
+func (h *Handler) ResetUserTwoFactor(c *gin.Context) {
+	userID, ok := parseTargetUserID(c)
+	if !ok {
+		return
+	}
+
+	if _, err := h.UserRepository.SelectByID(userID); err != nil {
+		utils.NotFound(c, "Kullanıcı")
+		return
+	}
+
+	if err := h.UserRepository.DisableTwoFactor(userID); err != nil {
+		utils.HandleDatabaseError(c, err, "2FA sıfırlama")
+		return
+	}
+
+	// 2FA sıfırlanan hesabın açık oturumları yeniden giriş gerektirir
+	if err := h.TokenRepository.RevokeAllUserTokens(userID, "Two-factor reset by admin"); err != nil {
+		utils.HandleDatabaseError(c, err, "Oturum sonlandırma")
+		return
+	}
+	h.SessionCache.InvalidateUserSessions(userID)
+
+	h.Audit.Record(c, types.AuditUserTwoFactorReset, types.AuditTargetUser, userID.String(), nil, nil)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Kullanıcının iki adımlı doğrulaması sıfırlandı.",
+	})
+}
@@ -0,0 +1,307 @@
+// handlers/admin/user-management.go
+package AdminHandler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// GetUsers kullanıcıları arama ve filtrelerle listeler (?search=&role=&status=&limit=&offset=)
+func (h *Handler) GetUsers(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	options := types.UserQueryOptions{
+		Search: c.Query("search"),
+		Role:   types.Role(c.Query("role")),
+		Status: types.UserStatus(c.Query("status")),
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	users, total, err := h.UserRepository.SelectUsers(options)
+	if err != nil {
+		if utils.HandleDatabaseError(c, err, "Kullanıcı listeleme") {
+			return
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"users":   users,
+		"total":   total,
+		"limit":   limit,
+		"offset":  offset,
+	})
+}
+
+// GetUserByID tek bir kullanıcının bilgilerini döndürür
+func (h *Handler) GetUserByID(c *gin.Context) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return
+	}
+
+	user, err := h.UserRepository.SelectByID(userID)
+	if err != nil {
+		utils.NotFound(c, "Kullanıcı")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"user":    toUserView(user),
+	})
+}
+
+// UpdateUserRole kullanıcının rolünü değiştirir. Yeni rol bir sonraki istekte geçerli olur.
+func (h *Handler) UpdateUserRole(c *gin.Context) {
+	userID, ok := parseTargetUserID(c)
+	if !ok {
+		return
+	}
+
+	var request types.UserRoleUpdateInput
+	if err := utils.ValidateRequest(c, &request); err != nil {
+		return
+	}
+
+	before, _ := h.UserRepository.SelectByID(userID)
+
+	if err := h.UserRepository.UpdateRole(userID, request.Role); err != nil {
+		utils.NotFound(c, "Kullanıcı")
+		return
+	}
+
+	h.SessionCache.InvalidateUserSessions(userID)
+	h.Audit.Record(c, types.AuditUserRoleUpdate, types.AuditTargetUser, userID.String(), gin.H{"role": before.Role}, gin.H{"role": request.Role})
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Kullanıcı rolü güncellendi.",
+		"role":    request.Role,
+	})
+}
+
+// UpdateUserStatus kullanıcıyı askıya alır veya yeniden aktifleştirir
+func (h *Handler) UpdateUserStatus(c *gin.Context) {
+	userID, ok := parseTargetUserID(c)
+	if !ok {
+		return
+	}
+
+	var request types.UserStatusUpdateInput
+	if err := utils.ValidateRequest(c, &request); err != nil {
+		return
+	}
+
+	before, _ := h.UserRepository.SelectByID(userID)
+
+	if err := h.UserRepository.UpdateStatus(userID, request.Status); err != nil {
+		utils.NotFound(c, "Kullanıcı")
+		return
+	}
+
+	// Askıya alma refresh token'ları trigger ile iptal eder; mevcut access token'lar da hemen geçersiz olmalı
+	h.SessionCache.InvalidateUserSessions(userID)
+	h.Audit.Record(c, types.AuditUserStatusUpdate, types.AuditTargetUser, userID.String(), gin.H{"status": before.Status}, gin.H{"status": request.Status})
+
+	message := "Kullanıcı yeniden aktifleştirildi."
+	if request.Status == types.UserStatusSuspended {
+		message = "Kullanıcı askıya alındı."
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": message,
+		"status":  request.Status,
+	})
+}
+
+// DeleteUser kullanıcıyı soft-delete yapar (status = Deleted)
+func (h *Handler) DeleteUser(c *gin.Context) {
+	userID, ok := parseTargetUserID(c)
+	if !ok {
+		return
+	}
+
+	before, _ := h.UserRepository.SelectByID(userID)
+
+	if err := h.UserRepository.UpdateStatus(userID, types.UserStatusDeleted); err != nil {
+		utils.NotFound(c, "Kullanıcı")
+		return
+	}
+
+	h.SessionCache.InvalidateUserSessions(userID)
+	h.Audit.Record(c, types.AuditUserDelete, types.AuditTargetUser, userID.String(), toUserView(before), gin.H{"status": types.UserStatusDeleted})
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Kullanıcı silindi.",
+	})
+}
+
+// RevokeUserSessions kullanıcının tüm oturumlarını sonlandırır ve API anahtarlarını iptal eder
+func (h *Handler) RevokeUserSessions(c *gin.Context) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.TokenRepository.RevokeAllUserTokens(userID, "Revoked by admin"); err != nil {
+		if utils.HandleDatabaseError(c, err, "Oturum sonlandırma") {
+			return
+		}
+		return
+	}
+
+	if err := h.TokenRepository.RevokeAllUserAPIKeys(userID); err != nil {
+		utils.HandleDatabaseError(c, err, "API anahtarı iptali")
+		return
+	}
+
+	h.SessionCache.InvalidateUserSessions(userID)
+	h.Audit.Record(c, types.AuditUserSessionsRevoke, types.AuditTargetUser, userID.String(), nil, nil)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Kullanıcının tüm oturumları sonlandırıldı ve API anahtarları iptal edildi.",
+	})
+}
+
+func parseUserIDParam(c *gin.Context) (uuid.UUID, bool) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_id",
+			"message": "Geçersiz kullanıcı ID formatı.",
+		})
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
+// parseTargetUserID adminin kendi hesabını kilitlemesini engeller
+func parseTargetUserID(c *gin.Context) (uuid.UUID, bool) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return uuid.Nil, false
+	}
+
+	if userID == c.MustGet("user_id").(uuid.UUID) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "self_action_not_allowed",
+			"message": "Bu işlemi kendi hesabınız üzerinde yapamazsınız.",
+		})
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
+func toUserView(user types.User) types.UserView {
+	return types.UserView{
+		ID:            user.ID,
+		Username:      user.Username,
+		Email:         user.Email,
+		Role:          user.Role,
+		EmailVerified: user.EmailVerified,
+		Status:        user.Status,
+		CreatedAt:     user.CreatedAt,
+		LastLogin:     user.LastLogin,
+	}
+}
+
+// GetUserSessions herhangi bir kullanıcının aktif oturumlarını listeler
+func (h *Handler) GetUserSessions(c *gin.Context) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return
+	}
+
+	tokens, err := h.TokenRepository.SelectActiveTokensByUserID(userID)
+	if err != nil {
+		if utils.HandleDatabaseError(c, err, "Oturum listeleme") {
+			return
+		}
+		return
+	}
+
+	currentToken, _ := utils.CurrentRefreshToken(c)
+	sessions := types.NewSessionViews(tokens, currentToken)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"sessions": sessions,
+		"count":    len(sessions),
+	})
+}
+
+// GetUserTokenReuseEvents bir kullanıcıya ait refresh token tekrar kullanım olaylarını listeler
+func (h *Handler) GetUserTokenReuseEvents(c *gin.Context) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return
+	}
+
+	events, err := h.TokenRepository.SelectTokenReuseEvents(userID)
+	if err != nil {
+		if utils.HandleDatabaseError(c, err, "Token olayları listeleme") {
+			return
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"events":  events,
+		"count":   len(events),
+	})
+}
+
+// RevokeUserSession herhangi bir kullanıcının tek bir oturumunu sonlandırır
+func (h *Handler) RevokeUserSession(c *gin.Context) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return
+	}
+
+	sessionID, err := uuid.Parse(c.Param("sessionId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_id",
+			"message": "Geçersiz oturum ID formatı.",
+		})
+		return
+	}
+
+	if err := h.TokenRepository.RevokeUserSession(userID, sessionID, "Revoked by admin"); err != nil {
+		utils.NotFound(c, "Oturum")
+		return
+	}
+
+	h.SessionCache.InvalidateUserSessions(userID)
+	h.Audit.Record(c, types.AuditUserSessionRevoke, types.AuditTargetSession, sessionID.String(), nil, gin.H{"userId": userID})
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Oturum sonlandırıldı.",
+	})
+}
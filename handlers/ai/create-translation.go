@@ -0,0 +1,77 @@
+package AIHandler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// CreateBlogTranslations kaynak blogu hedef dillere çevirip aynı çeviri grubunda taslak bloglar oluşturur.
+// ?stream=true ile her dilin ilerlemesi "progress" SSE olayı olarak, özet ise "complete" olayı olarak gönderilir.
+func (h *Handler) CreateBlogTranslations(c *gin.Context) {
+	var request types.CreateTranslationRequest
+	if err := utils.ValidateRequest(c, &request); err != nil {
+		return
+	}
+
+	source, err := h.BlogRepository.SelectBlogByID(request.SourceID)
+	if err != nil || source == nil || source.Status == types.BlogStatusDeleted {
+		utils.NotFound(c, "Kaynak blog yazısı")
+		return
+	}
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+	stream := c.Query("stream") == "true"
+
+	var onProgress func(types.TranslationProgress)
+	if stream {
+		c.Header("Content-Type", "text/event-stream")
+		c.Header("Cache-Control", "no-cache")
+		c.Header("Connection", "keep-alive")
+		c.Header("X-Accel-Buffering", "no")
+		c.Status(http.StatusOK)
+
+		onProgress = func(progress types.TranslationProgress) {
+			c.SSEvent("progress", progress)
+			c.Writer.Flush()
+		}
+	}
+
+	report, created := h.AIService.CreateTranslations(c.Request.Context(), source, request.TargetLanguages, userID, onProgress)
+
+	// Token kullanımını context'e kaydet (rate limiter için)
+	c.Set("tokens_used", report.InputTokens+report.OutputTokens)
+
+	if len(created) > 0 {
+		h.BlogCache.InvalidateAllBlogs()
+		for _, blog := range created {
+			h.Audit.Record(c, types.AuditBlogAITranslate, types.AuditTargetBlog, blog.ID,
+				gin.H{"sourceId": source.ID, "sourceLanguage": source.Language}, blog)
+		}
+	}
+
+	if stream {
+		c.SSEvent("complete", report)
+		c.Writer.Flush()
+		return
+	}
+
+	if report.Created == 0 && report.Failed > 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "translation_failed",
+			"message": "Hiçbir dilde çeviri oluşturulamadı.",
+			"report":  report,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Çeviri işlemi tamamlandı.",
+		"report":  report,
+	})
+}
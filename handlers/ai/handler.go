@@ -4,18 +4,24 @@ import (
 	AIRepository "github.com/okanay/backend-blog-guideofdubai/repositories/ai"
 	BlogRepository "github.com/okanay/backend-blog-guideofdubai/repositories/blog"
 	AIService "github.com/okanay/backend-blog-guideofdubai/services/ai"
+	AuditService "github.com/okanay/backend-blog-guideofdubai/services/audit"
+	"github.com/okanay/backend-blog-guideofdubai/services/cache"
 )
 
 type Handler struct {
 	AIRepository   *AIRepository.Repository
 	BlogRepository *BlogRepository.Repository
 	AIService      *AIService.AIService
+	BlogCache      *cache.BlogCacheService
+	Audit          *AuditService.AuditService
 }
 
-func NewHandler(ai *AIRepository.Repository, blog *BlogRepository.Repository, ais *AIService.AIService) *Handler {
+func NewHandler(ai *AIRepository.Repository, blog *BlogRepository.Repository, ais *AIService.AIService, c *cache.Cache, a *AuditService.AuditService) *Handler {
 	return &Handler{
 		AIRepository:   ai,
 		BlogRepository: blog,
 		AIService:      ais,
+		BlogCache:      cache.NewBlogCacheService(c),
+		Audit:          a,
 	}
 }
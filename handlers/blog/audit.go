@@ -0,0 +1,33 @@
+package BlogHandler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+)
+
+// blogStatusSnapshot durum değişikliklerinde audit kaydına yalnızca yayın alanlarını yazar
+func blogStatusSnapshot(blog *types.BlogPostView) gin.H {
+	if blog == nil {
+		return nil
+	}
+
+	return gin.H{
+		"status":      blog.Status,
+		"scheduledAt": blog.ScheduledAt,
+		"publishedAt": blog.PublishedAt,
+	}
+}
+
+// featuredOrderSnapshot bir dildeki featured sıralamasını blog ID listesi olarak döndürür
+func (h *Handler) featuredOrderSnapshot(language string) []string {
+	blogs, err := h.BlogRepository.GetFeaturedBlogs(language)
+	if err != nil {
+		return nil
+	}
+
+	ids := make([]string, len(blogs))
+	for i, blog := range blogs {
+		ids[i] = blog.ID
+	}
+	return ids
+}
@@ -0,0 +1,206 @@
+package BlogHandler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// SaveBlogDraft editörün çalışma kopyasını otomatik kaydeder, canlı içerik değişmez
+func (h *Handler) SaveBlogDraft(c *gin.Context) {
+	blogID, ok := parseBlogIDParam(c)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeBlog(c, configs.EditPost, blogID) {
+		return
+	}
+
+	var request types.BlogDraftInput
+	err := utils.ValidateRequest(c, &request)
+	if err != nil {
+		return
+	}
+
+	if !renderContentHTML(c, &request.Content) {
+		return
+	}
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+	draft, err := h.BlogRepository.UpsertBlogDraft(blogID, userID, request)
+	if err != nil {
+		if utils.HandleDatabaseError(c, err, "Taslak kaydetme") {
+			return
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Taslak kaydedildi.",
+		"draft":   draft,
+	})
+}
+
+// SelectBlogDraft blogun çalışma kopyasını döndürür
+func (h *Handler) SelectBlogDraft(c *gin.Context) {
+	blogID, ok := parseBlogIDParam(c)
+	if !ok {
+		return
+	}
+
+	draft, err := h.BlogRepository.SelectBlogDraft(blogID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "database_error",
+			"message": "Taslak getirilemedi: " + err.Error(),
+		})
+		return
+	}
+
+	if draft == nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "draft_not_found",
+			"message": "Bu blog için kaydedilmiş bir taslak bulunamadı.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"draft":   draft,
+	})
+}
+
+// DeleteBlogDraft çalışma kopyasını siler, canlı içerik olduğu gibi kalır
+func (h *Handler) DeleteBlogDraft(c *gin.Context) {
+	blogID, ok := parseBlogIDParam(c)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeBlog(c, configs.EditPost, blogID) {
+		return
+	}
+
+	err := h.BlogRepository.DeleteBlogDraft(blogID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "draft_not_found",
+			"message": "Bu blog için kaydedilmiş bir taslak bulunamadı.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Taslak silindi.",
+	})
+}
+
+// PublishBlogDraft taslaktaki değişiklikleri canlı içeriğe aktarır
+func (h *Handler) PublishBlogDraft(c *gin.Context) {
+	blogID, ok := parseBlogIDParam(c)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeBlog(c, configs.EditPost, blogID) {
+		return
+	}
+
+	before, _ := h.BlogRepository.SelectBlogByID(blogID)
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+	blog, err := h.BlogRepository.PublishBlogDraft(blogID, userID)
+	if err != nil {
+		if utils.HandleDatabaseError(c, err, "Taslak yayınlama") {
+			return
+		}
+		return
+	}
+
+	h.BlogCache.InvalidateAllBlogs()
+	h.Audit.Record(c, types.AuditBlogDraftPublish, types.AuditTargetBlog, blogID.String(), before, blog)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Değişiklikler başarıyla yayınlandı.",
+		"blog":    blog,
+	})
+}
+
+// SelectEditorBlogByID editörler için blogu cache kullanmadan döndürür.
+// ?version=draft ile varsa çalışma kopyası, aksi halde canlı versiyon döner.
+func (h *Handler) SelectEditorBlogByID(c *gin.Context) {
+	blogID, ok := parseBlogIDParam(c)
+	if !ok {
+		return
+	}
+
+	version := c.DefaultQuery("version", "live")
+	if version != "live" && version != "draft" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_version",
+			"message": "version parametresi 'live' veya 'draft' olmalıdır.",
+		})
+		return
+	}
+
+	var blog *types.BlogPostView
+	var draft *types.BlogDraft
+	var err error
+
+	if version == "draft" {
+		blog, draft, err = h.BlogRepository.SelectBlogDraftView(blogID)
+	} else {
+		blog, err = h.BlogRepository.SelectBlogByID(blogID)
+		if err == nil {
+			draft, err = h.BlogRepository.SelectBlogDraft(blogID)
+		}
+	}
+
+	if err != nil || blog == nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "blog_not_found",
+			"message": "Blog yazısı bulunamadı.",
+		})
+		return
+	}
+
+	response := gin.H{
+		"success":  true,
+		"blog":     blog,
+		"version":  version,
+		"hasDraft": draft != nil,
+	}
+	if draft != nil {
+		response["draftUpdatedAt"] = draft.UpdatedAt
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func parseBlogIDParam(c *gin.Context) (uuid.UUID, bool) {
+	blogID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_id",
+			"message": "Geçersiz blog ID formatı.",
+		})
+		return uuid.Nil, false
+	}
+
+	return blogID, true
+}
@@ -0,0 +1,163 @@
+package BlogHandler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	BlogRepository "github.com/okanay/backend-blog-guideofdubai/repositories/blog"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// GetBlogGroup çeviri grubundaki dilleri, durumlarını, son güncellemelerini ve eksik dilleri döndürür
+func (h *Handler) GetBlogGroup(c *gin.Context) {
+	groupID := c.Param("groupId")
+
+	posts, err := h.BlogRepository.SelectBlogGroup(groupID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Çeviri grubu")
+		return
+	}
+
+	if len(posts) == 0 {
+		utils.NotFound(c, "Çeviri grubu")
+		return
+	}
+
+	allLanguages, err := h.BlogRepository.SelectBlogLanguages()
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Dil listesi")
+		return
+	}
+
+	overview := types.BlogGroupOverview{
+		GroupID:          groupID,
+		Posts:            posts,
+		Languages:        []string{},
+		MissingLanguages: []string{},
+	}
+
+	present := make(map[string]bool, len(posts))
+	for i, post := range posts {
+		present[post.Language] = true
+		overview.Languages = append(overview.Languages, post.Language)
+
+		if overview.LastUpdatedAt == nil || post.UpdatedAt.After(*overview.LastUpdatedAt) {
+			overview.LastUpdatedAt = &posts[i].UpdatedAt
+		}
+	}
+
+	for _, language := range allLanguages {
+		if !present[language] {
+			overview.MissingLanguages = append(overview.MissingLanguages, language)
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"group":   overview,
+	})
+}
+
+// AttachBlogToGroup blogu mevcut bir çeviri grubuna bağlar (grupta aynı dilde başka blog olmamalı)
+func (h *Handler) AttachBlogToGroup(c *gin.Context) {
+	blogID, ok := parseBlogIDParam(c)
+	if !ok {
+		return
+	}
+
+	var request types.BlogGroupAttachInput
+	if err := utils.ValidateRequest(c, &request); err != nil {
+		return
+	}
+
+	if !h.authorizeBlog(c, configs.EditPost, blogID) {
+		return
+	}
+
+	previousGroupID, err := h.BlogRepository.MoveBlogToGroup(blogID, request.GroupID, true)
+	if err != nil {
+		respondGroupMoveError(c, err)
+		return
+	}
+
+	h.BlogCache.InvalidateAllBlogs()
+	h.Audit.Record(c, types.AuditBlogGroupAttach, types.AuditTargetBlog, blogID.String(),
+		gin.H{"groupId": previousGroupID}, gin.H{"groupId": request.GroupID})
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Blog yazısı çeviri grubuna bağlandı.",
+		"groupId": request.GroupID,
+	})
+}
+
+// DetachBlogFromGroup blogu bulunduğu çeviri grubundan ayırıp kendine ait yeni bir gruba taşır
+func (h *Handler) DetachBlogFromGroup(c *gin.Context) {
+	blogID, ok := parseBlogIDParam(c)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeBlog(c, configs.EditPost, blogID) {
+		return
+	}
+
+	blog, err := h.BlogRepository.SelectBlogByID(blogID)
+	if err != nil || blog == nil || blog.Status == types.BlogStatusDeleted {
+		utils.NotFound(c, "Blog yazısı")
+		return
+	}
+
+	members, err := h.BlogRepository.SelectBlogGroup(blog.GroupID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Çeviri grubu")
+		return
+	}
+
+	if len(members) <= 1 {
+		utils.BadRequest(c, "Blog yazısı zaten çeviri grubunda tek başına.")
+		return
+	}
+
+	newGroupID, err := h.BlogRepository.GenerateDetachedGroupID(blogID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Grup oluşturma")
+		return
+	}
+
+	previousGroupID, err := h.BlogRepository.MoveBlogToGroup(blogID, newGroupID, false)
+	if err != nil {
+		respondGroupMoveError(c, err)
+		return
+	}
+
+	h.BlogCache.InvalidateAllBlogs()
+	h.Audit.Record(c, types.AuditBlogGroupDetach, types.AuditTargetBlog, blogID.String(),
+		gin.H{"groupId": previousGroupID}, gin.H{"groupId": newGroupID})
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Blog yazısı çeviri grubundan ayrıldı.",
+		"groupId": newGroupID,
+	})
+}
+
+func respondGroupMoveError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, BlogRepository.ErrBlogNotFound):
+		utils.NotFound(c, "Blog yazısı")
+	case errors.Is(err, BlogRepository.ErrBlogGroupNotFound):
+		utils.NotFound(c, "Çeviri grubu")
+	case errors.Is(err, BlogRepository.ErrBlogGroupLanguageTaken):
+		c.JSON(http.StatusConflict, gin.H{
+			"success": false,
+			"error":   "group_language_exists",
+			"message": "Bu çeviri grubunda aynı dilde başka bir blog yazısı zaten var.",
+		})
+	default:
+		utils.HandleDatabaseError(c, err, "Çeviri grubu güncelleme")
+	}
+}
@@ -0,0 +1,298 @@
+package BlogHandler
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// SelectBlogRevisions bir bloga ait revizyon listesini döndürür
+func (h *Handler) SelectBlogRevisions(c *gin.Context) {
+	blogID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_id",
+			"message": "Geçersiz blog ID formatı.",
+		})
+		return
+	}
+
+	if !h.authorizeBlog(c, configs.EditPost, blogID) {
+		return
+	}
+
+	revisions, err := h.BlogRepository.SelectBlogRevisions(blogID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "database_error",
+			"message": "Revizyonlar getirilemedi: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":   true,
+		"revisions": revisions,
+		"count":     len(revisions),
+	})
+}
+
+// SelectBlogRevisionByID tek bir revizyonu tüm içeriğiyle döndürür
+func (h *Handler) SelectBlogRevisionByID(c *gin.Context) {
+	blogID, revisionID, ok := parseRevisionParams(c)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeBlog(c, configs.EditPost, blogID) {
+		return
+	}
+
+	revision, err := h.BlogRepository.SelectBlogRevisionByID(blogID, revisionID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "revision_not_found",
+			"message": "Revizyon bulunamadı.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"revision": revision,
+	})
+}
+
+// CompareBlogRevisions iki revizyon arasındaki farkları döndürür (?from=&to=)
+func (h *Handler) CompareBlogRevisions(c *gin.Context) {
+	blogID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_id",
+			"message": "Geçersiz blog ID formatı.",
+		})
+		return
+	}
+
+	fromID, fromErr := uuid.Parse(c.Query("from"))
+	toID, toErr := uuid.Parse(c.Query("to"))
+	if fromErr != nil || toErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_revision_id",
+			"message": "from ve to parametreleri geçerli revizyon ID'leri olmalıdır.",
+		})
+		return
+	}
+
+	if !h.authorizeBlog(c, configs.EditPost, blogID) {
+		return
+	}
+
+	from, err := h.BlogRepository.SelectBlogRevisionByID(blogID, fromID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "revision_not_found",
+			"message": "Karşılaştırılacak ilk revizyon bulunamadı.",
+		})
+		return
+	}
+
+	to, err := h.BlogRepository.SelectBlogRevisionByID(blogID, toID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "revision_not_found",
+			"message": "Karşılaştırılacak ikinci revizyon bulunamadı.",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"diff":    compareRevisions(from, to),
+	})
+}
+
+// RestoreBlogRevision bir revizyonu normal güncelleme akışı üzerinden geri yükler
+func (h *Handler) RestoreBlogRevision(c *gin.Context) {
+	blogID, revisionID, ok := parseRevisionParams(c)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeBlog(c, configs.EditPost, blogID) {
+		return
+	}
+
+	revision, err := h.BlogRepository.SelectBlogRevisionByID(blogID, revisionID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "revision_not_found",
+			"message": "Revizyon bulunamadı.",
+		})
+		return
+	}
+
+	current, err := h.BlogRepository.SelectBlogByID(blogID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "blog_not_found",
+			"message": "Blog yazısı bulunamadı.",
+		})
+		return
+	}
+
+	// İçerik revizyondan gelir, yayın durumu ise mevcut haliyle korunur
+	request := types.BlogUpdateInput{
+		ID:       blogID.String(),
+		GroupID:  revision.GroupID,
+		Slug:     revision.Slug,
+		Language: revision.Language,
+		Status:   current.Status,
+		// Zamanlanmış bir yazıda yayın tarihi de korunur
+		ScheduledAt: current.ScheduledAt,
+		Metadata: types.MetadataInput{
+			Title:       revision.Metadata.Title,
+			Description: revision.Metadata.Description,
+			Image:       revision.Metadata.Image,
+		},
+		Content: types.ContentInput{
+			Title:       revision.Content.Title,
+			Description: revision.Content.Description,
+			Image:       revision.Content.Image,
+			ReadTime:    revision.Content.ReadTime,
+			// Revizyonlarda override bilgisi tutulmaz; mevcut tercih korunur
+			ReadTimeOverride: current.Content.ReadTimeOverride,
+			HTML:             revision.Content.HTML,
+			JSON:             revision.Content.JSON,
+		},
+		Categories: revision.Categories,
+		Tags:       revision.Tags,
+	}
+
+	// Eski revizyonların HTML'i istemciden gelmiş olabilir; JSON'dan yeniden üretilir
+	if !renderContentHTML(c, &request.Content) {
+		return
+	}
+
+	// Revizyon, içerik kuralları eklenmeden önce kaydedilmiş olabilir; güncelleme isteğiyle aynı kurallar uygulanır
+	if err := utils.ValidateInput(request); err != nil {
+		utils.RespondValidationError(c, err)
+		return
+	}
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+	blog, err := h.BlogRepository.UpdateBlogPost(request, userID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Revizyon geri yükleme")
+		return
+	}
+
+	h.BlogCache.InvalidateAllBlogs()
+	h.Audit.Record(c, types.AuditBlogRevisionRestore, types.AuditTargetBlog, blogID.String(), current, blog)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Revizyon başarıyla geri yüklendi.",
+		"blog":    blog,
+	})
+}
+
+func parseRevisionParams(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	blogID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_id",
+			"message": "Geçersiz blog ID formatı.",
+		})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	revisionID, err := uuid.Parse(c.Param("revisionId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_revision_id",
+			"message": "Geçersiz revizyon ID formatı.",
+		})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return blogID, revisionID, true
+}
+
+func compareRevisions(from, to *types.BlogRevision) types.BlogRevisionDiff {
+	diff := types.BlogRevisionDiff{
+		From:        revisionSummary(from),
+		To:          revisionSummary(to),
+		Title:       fieldDiff(from.Metadata.Title, to.Metadata.Title),
+		Description: fieldDiff(from.Metadata.Description, to.Metadata.Description),
+		Categories:  listDiff(from.Categories, to.Categories),
+		Tags:        listDiff(from.Tags, to.Tags),
+	}
+
+	diff.HTML.Changed = from.Content.HTML != to.Content.HTML
+	if diff.HTML.Changed {
+		diff.HTML.Lines = utils.DiffLines(from.Content.HTML, to.Content.HTML)
+	} else {
+		diff.HTML.Lines = []types.DiffLine{}
+	}
+
+	return diff
+}
+
+func revisionSummary(revision *types.BlogRevision) types.BlogRevisionSummary {
+	return types.BlogRevisionSummary{
+		ID:             revision.ID,
+		RevisionNumber: revision.RevisionNumber,
+		UserID:         revision.UserID,
+		Username:       revision.Username,
+		Title:          revision.Metadata.Title,
+		Slug:           revision.Slug,
+		Status:         revision.Status,
+		CreatedAt:      revision.CreatedAt,
+	}
+}
+
+func fieldDiff(from, to string) types.BlogRevisionFieldDiff {
+	return types.BlogRevisionFieldDiff{
+		Changed: from != to,
+		From:    from,
+		To:      to,
+	}
+}
+
+func listDiff(from, to []string) types.BlogRevisionListDiff {
+	diff := types.BlogRevisionListDiff{
+		Added:   []string{},
+		Removed: []string{},
+	}
+
+	for _, value := range to {
+		if !slices.Contains(from, value) {
+			diff.Added = append(diff.Added, value)
+		}
+	}
+	for _, value := range from {
+		if !slices.Contains(to, value) {
+			diff.Removed = append(diff.Removed, value)
+		}
+	}
+
+	diff.Changed = len(diff.Added) > 0 || len(diff.Removed) > 0
+	return diff
+}
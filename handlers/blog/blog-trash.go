@@ -0,0 +1,110 @@
+package BlogHandler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	BlogRepository "github.com/okanay/backend-blog-guideofdubai/repositories/blog"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// GetTrashedBlogs çöp kutusundaki blogları kimin ne zaman sildiği bilgisiyle listeler (?limit=&offset=)
+func (h *Handler) GetTrashedBlogs(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	blogs, total, err := h.BlogRepository.SelectTrashedBlogs(limit, offset)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Çöp kutusu listeleme")
+		return
+	}
+
+	for i := range blogs {
+		blogs[i].PurgeAt = h.Trash.PurgeAt(blogs[i].DeletedAt)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":       true,
+		"blogs":         blogs,
+		"total":         total,
+		"limit":         limit,
+		"offset":        offset,
+		"retentionDays": int(h.Trash.Retention.Hours() / 24),
+	})
+}
+
+// RestoreTrashedBlog çöp kutusundaki blogu silinmeden önceki durumuna geri yükler
+func (h *Handler) RestoreTrashedBlog(c *gin.Context) {
+	blogID, ok := parseBlogIDParam(c)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeBlog(c, configs.DeletePost, blogID) {
+		return
+	}
+
+	status, err := h.BlogRepository.RestoreTrashedBlog(blogID)
+	if errors.Is(err, BlogRepository.ErrBlogNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "trashed_blog_not_found",
+			"message": "Çöp kutusunda bu blog yazısı bulunamadı.",
+		})
+		return
+	}
+	if err != nil {
+		// Aynı çeviri grubunda aynı dilde yeni bir yazı oluşturulmuşsa geri yükleme çakışır
+		utils.HandleDatabaseError(c, err, "Geri yükleme")
+		return
+	}
+
+	h.BlogCache.InvalidateBlogByID(blogID)
+	h.BlogCache.InvalidateAllBlogs()
+	h.Audit.Record(c, types.AuditBlogRestore, types.AuditTargetBlog, blogID.String(),
+		gin.H{"status": types.BlogStatusDeleted}, gin.H{"status": status})
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Blog yazısı geri yüklendi.",
+		"status":  status,
+	})
+}
+
+// PurgeTrashedBlog çöp kutusundaki blogu kalıcı olarak siler (yalnızca admin)
+func (h *Handler) PurgeTrashedBlog(c *gin.Context) {
+	blogID, ok := parseBlogIDParam(c)
+	if !ok {
+		return
+	}
+
+	purged, err := h.Trash.PurgeBlog(c.Request.Context(), blogID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "trashed_blog_not_found",
+			"message": "Çöp kutusunda bu blog yazısı bulunamadı. Yalnızca çöp kutusundaki yazılar kalıcı olarak silinebilir.",
+		})
+		return
+	}
+
+	h.Audit.Record(c, types.AuditBlogPurge, types.AuditTargetBlog, blogID.String(),
+		gin.H{"slug": purged.Slug, "language": purged.Language, "status": types.BlogStatusDeleted}, nil)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":       true,
+		"message":       "Blog yazısı kalıcı olarak silindi.",
+		"deletedImages": len(purged.OrphanImageURLs),
+	})
+}
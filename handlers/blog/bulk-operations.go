@@ -0,0 +1,195 @@
+package BlogHandler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// bulkActionPermissions her toplu işlemin gerektirdiği izin
+var bulkActionPermissions = map[types.BlogBulkAction]configs.Permission{
+	types.BlogBulkStatus:           configs.EditPost,
+	types.BlogBulkAddTags:          configs.EditPost,
+	types.BlogBulkRemoveTags:       configs.EditPost,
+	types.BlogBulkAddCategories:    configs.EditPost,
+	types.BlogBulkRemoveCategories: configs.EditPost,
+	types.BlogBulkFeature:          configs.ManageFeatured,
+	types.BlogBulkUnfeature:        configs.ManageFeatured,
+	types.BlogBulkDelete:           configs.DeletePost,
+}
+
+// BulkUpdateBlogs ID listesi veya filtre ile seçilen bloglara tek transaction içinde toplu işlem uygular.
+// Herhangi bir blog başarısız olursa hiçbir değişiklik kaydedilmez; dryRun ile sonuç önceden görülebilir.
+func (h *Handler) BulkUpdateBlogs(c *gin.Context) {
+	var request types.BlogBulkInput
+	if err := utils.ValidateRequest(c, &request); err != nil {
+		return
+	}
+
+	permission, ok := bulkActionPermissions[request.Action]
+	if !ok {
+		utils.BadRequest(c, "Geçersiz toplu işlem. Geçerli işlemler: status, add_tags, remove_tags, add_categories, remove_categories, feature, unfeature, delete.")
+		return
+	}
+
+	if !validateBulkInput(c, request) {
+		return
+	}
+
+	role := c.MustGet("role").(types.Role)
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	var ownerID *uuid.UUID
+	switch configs.GetAccess(role, permission) {
+	case configs.AccessNone:
+		utils.Forbidden(c, "Bu toplu işlem için yetkiniz yok.")
+		return
+	case configs.AccessOwn:
+		ownerID = &userID
+	}
+
+	ids, ok := h.resolveBulkTargets(c, request)
+	if !ok {
+		return
+	}
+
+	report, err := h.BlogRepository.ApplyBlogBulkOperation(request, ids, userID, ownerID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Toplu işlem")
+		return
+	}
+
+	if report.Committed && report.Updated > 0 {
+		// Cache tüm işlem bittikten sonra tek seferde temizlenir
+		h.BlogCache.InvalidateAllBlogs()
+
+		after := bulkAuditSnapshot(request)
+		for _, item := range report.Items {
+			if item.Result == types.BlogBulkResultUpdated {
+				h.Audit.Record(c, types.AuditBlogBulkUpdate, types.AuditTargetBlog, item.ID.String(),
+					gin.H{"status": item.Previous}, after)
+			}
+		}
+	}
+
+	if report.Failed > 0 && !request.DryRun {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"success": false,
+			"error":   "bulk_operation_failed",
+			"message": fmt.Sprintf("%d blog yazısında işlem başarısız olduğu için hiçbir değişiklik kaydedilmedi.", report.Failed),
+			"report":  report,
+		})
+		return
+	}
+
+	message := "Toplu işlem tamamlandı."
+	if request.DryRun {
+		message = "Deneme modu: hiçbir değişiklik kaydedilmedi."
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": message,
+		"report":  report,
+	})
+}
+
+// validateBulkInput işleme göre gerekli alanları ve hedef seçimini doğrular
+func validateBulkInput(c *gin.Context, request types.BlogBulkInput) bool {
+	if (len(request.IDs) > 0) == (request.Filter != nil) {
+		utils.BadRequest(c, "Hedef bloglar için ids veya filter alanlarından yalnızca biri gönderilmelidir.")
+		return false
+	}
+
+	switch request.Action {
+	case types.BlogBulkStatus:
+		switch request.Status {
+		case types.BlogStatusDraft, types.BlogStatusPublished, types.BlogStatusArchived:
+		default:
+			utils.BadRequest(c, "Toplu durum değişikliği için status draft, published veya archived olmalıdır. Zamanlama ve silme için ilgili işlemleri kullanın.")
+			return false
+		}
+	case types.BlogBulkAddTags, types.BlogBulkRemoveTags:
+		if len(request.Tags) == 0 {
+			utils.BadRequest(c, "Bu işlem için en az bir etiket gönderilmelidir.")
+			return false
+		}
+	case types.BlogBulkAddCategories, types.BlogBulkRemoveCategories:
+		if len(request.Categories) == 0 {
+			utils.BadRequest(c, "Bu işlem için en az bir kategori gönderilmelidir.")
+			return false
+		}
+	}
+
+	return true
+}
+
+// resolveBulkTargets işlem uygulanacak blog ID'lerini tekilleştirerek döndürür.
+// Filtre, blog kartı listesiyle aynı kuralları kullanır (silinmiş bloglar dahil edilmez).
+func (h *Handler) resolveBulkTargets(c *gin.Context, request types.BlogBulkInput) ([]uuid.UUID, bool) {
+	ids := request.IDs
+
+	if request.Filter != nil {
+		options := *request.Filter
+		options.Limit = configs.BLOG_BULK_MAX_ITEMS + 1
+		options.Offset = 0
+
+		page, err := h.BlogRepository.SelectBlogCards(options)
+		if err != nil {
+			utils.HandleDatabaseError(c, err, "Toplu işlem filtresi")
+			return nil, false
+		}
+
+		ids = make([]uuid.UUID, 0, len(page.Blogs))
+		for _, blog := range page.Blogs {
+			id, err := uuid.Parse(blog.ID)
+			if err == nil {
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	seen := make(map[uuid.UUID]bool, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	if len(unique) == 0 {
+		utils.NotFound(c, "İşlem uygulanacak blog yazısı")
+		return nil, false
+	}
+
+	if len(unique) > configs.BLOG_BULK_MAX_ITEMS {
+		utils.BadRequest(c, fmt.Sprintf("Tek seferde en fazla %d blog yazısı işlenebilir. Filtreyi daraltın.", configs.BLOG_BULK_MAX_ITEMS))
+		return nil, false
+	}
+
+	return unique, true
+}
+
+// bulkAuditSnapshot toplu işlemin audit kaydına yazılacak parametrelerini döndürür
+func bulkAuditSnapshot(request types.BlogBulkInput) gin.H {
+	after := gin.H{"action": request.Action}
+
+	switch request.Action {
+	case types.BlogBulkStatus:
+		after["status"] = request.Status
+	case types.BlogBulkAddTags, types.BlogBulkRemoveTags:
+		after["tags"] = request.Tags
+	case types.BlogBulkAddCategories, types.BlogBulkRemoveCategories:
+		after["categories"] = request.Categories
+	case types.BlogBulkDelete:
+		after["status"] = types.BlogStatusDeleted
+	}
+
+	return after
+}
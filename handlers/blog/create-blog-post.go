@@ -17,6 +17,14 @@ func (h *Handler) CreateBlogPost(c *gin.Context) {
 		return
 	}
 
+	if request.Status == types.BlogStatusScheduled && !validateScheduledAt(c, request.ScheduledAt) {
+		return
+	}
+
+	if !renderContentHTML(c, &request.Content) {
+		return
+	}
+
 	userID := c.MustGet("user_id").(uuid.UUID)
 	blog, err := h.BlogRepository.CreateBlogPost(request, userID)
 
@@ -36,6 +44,7 @@ func (h *Handler) CreateBlogPost(c *gin.Context) {
 	// Yeni blog yazısı oluşturulduğunda, tüm listeleme önbellekleri geçersiz hale gelir
 	// özellikle recent posts ve kategori/etiket listeleri etkilenecektir
 	h.BlogCache.InvalidateAllBlogs()
+	h.Audit.Record(c, types.AuditBlogCreate, types.AuditTargetBlog, blog.ID, nil, blog)
 
 	c.JSON(http.StatusCreated, gin.H{
 		"success": true,
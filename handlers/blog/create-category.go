@@ -28,6 +28,7 @@ func (h *Handler) CreateBlogCategory(c *gin.Context) {
 	}
 
 	h.BlogCache.InvalidateAllBlogs()
+	h.Audit.Record(c, types.AuditCategoryCreate, types.AuditTargetCategory, category.Name, nil, category)
 
 	c.JSON(http.StatusCreated, gin.H{
 		"success":  true,
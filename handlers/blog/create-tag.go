@@ -29,6 +29,7 @@ func (h *Handler) CreateBlogTag(c *gin.Context) {
 	}
 
 	h.BlogCache.InvalidateAllBlogs()
+	h.Audit.Record(c, types.AuditTagCreate, types.AuditTargetTag, tag.Name, nil, tag)
 
 	c.JSON(http.StatusCreated, gin.H{
 		"success": true,
@@ -2,9 +2,12 @@ package BlogHandler
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	"github.com/okanay/backend-blog-guideofdubai/types"
 )
 
 func (h *Handler) DeleteBlogByID(c *gin.Context) {
@@ -18,11 +21,20 @@ func (h *Handler) DeleteBlogByID(c *gin.Context) {
 		return
 	}
 
-	err = h.BlogRepository.HardDeleteBlogByID(id)
+	if !h.authorizeBlog(c, configs.DeletePost, id) {
+		return
+	}
+
+	before, _ := h.BlogRepository.SelectBlogByID(id)
+
+	// Silme işlemi blogu çöp kutusuna taşır; kalıcı silme admin tarafından veya saklama süresi dolunca yapılır
+	userID := c.MustGet("user_id").(uuid.UUID)
+	err = h.BlogRepository.DeleteBlogByID(id, userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
+		c.JSON(http.StatusNotFound, gin.H{
 			"success": false,
-			"error":   err.Error(),
+			"error":   "blog_not_found",
+			"message": "Blog yazısı bulunamadı veya zaten çöp kutusunda.",
 		})
 		return
 	}
@@ -32,8 +44,11 @@ func (h *Handler) DeleteBlogByID(c *gin.Context) {
 
 	// Blog silindiğinde tüm listeler etkileneceğinden tüm cache'i temizle
 	h.BlogCache.InvalidateAllBlogs()
+	h.Audit.Record(c, types.AuditBlogDelete, types.AuditTargetBlog, id.String(), blogStatusSnapshot(before), gin.H{"status": types.BlogStatusDeleted})
 
-	c.JSON(http.StatusCreated, gin.H{
+	c.JSON(http.StatusOK, gin.H{
 		"success": true,
+		"message": "Blog yazısı çöp kutusuna taşındı.",
+		"purgeAt": h.Trash.PurgeAt(time.Now()),
 	})
 }
@@ -29,6 +29,7 @@ func (h *Handler) AddToFeatured(c *gin.Context) {
 
 	// Cache'i temizle
 	h.BlogCache.InvalidateAllBlogs()
+	h.Audit.Record(c, types.AuditFeaturedAdd, types.AuditTargetBlog, request.BlogID.String(), nil, request)
 
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
@@ -59,6 +60,7 @@ func (h *Handler) RemoveFromFeatured(c *gin.Context) {
 
 	// Tüm dillerdeki cache'i temizle
 	h.BlogCache.InvalidateAllBlogs()
+	h.Audit.Record(c, types.AuditFeaturedRemove, types.AuditTargetBlog, blogID.String(), gin.H{"featured": true}, gin.H{"featured": false})
 
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
@@ -75,6 +77,8 @@ func (h *Handler) UpdateFeaturedOrdering(c *gin.Context) {
 		return
 	}
 
+	before := h.featuredOrderSnapshot(request.Language)
+
 	err = h.BlogRepository.UpdateFeaturedOrdering(request.Language, request.BlogIDs)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
@@ -86,6 +90,8 @@ func (h *Handler) UpdateFeaturedOrdering(c *gin.Context) {
 
 	// İlgili dilin cache'ini temizle
 	h.BlogCache.InvalidateAllBlogs()
+	h.Audit.Record(c, types.AuditFeaturedReorder, types.AuditTargetFeatured, request.Language,
+		gin.H{"blogIds": before}, gin.H{"blogIds": request.BlogIDs})
 
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
@@ -3,19 +3,28 @@ package BlogHandler
 
 import (
 	BlogRepository "github.com/okanay/backend-blog-guideofdubai/repositories/blog"
+	AnalyticsService "github.com/okanay/backend-blog-guideofdubai/services/analytics"
+	AuditService "github.com/okanay/backend-blog-guideofdubai/services/audit"
 	"github.com/okanay/backend-blog-guideofdubai/services/cache"
+	TrashService "github.com/okanay/backend-blog-guideofdubai/services/trash"
 )
 
 type Handler struct {
 	BlogRepository *BlogRepository.Repository
 	Cache          *cache.Cache
 	BlogCache      *cache.BlogCacheService
+	Audit          *AuditService.AuditService
+	Trash          *TrashService.TrashService
+	Analytics      *AnalyticsService.AnalyticsService
 }
 
-func NewHandler(b *BlogRepository.Repository, c *cache.Cache) *Handler {
+func NewHandler(b *BlogRepository.Repository, c *cache.Cache, a *AuditService.AuditService, t *TrashService.TrashService, an *AnalyticsService.AnalyticsService) *Handler {
 	return &Handler{
 		BlogRepository: b,
 		Cache:          c,
 		BlogCache:      cache.NewBlogCacheService(c),
+		Audit:          a,
+		Trash:          t,
+		Analytics:      an,
 	}
 }
@@ -0,0 +1,40 @@
+package BlogHandler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// authorizeBlog çağıranın blog üzerinde verilen izne sahip olup olmadığını kontrol eder.
+// "own" erişiminde blog_posts.user_id ile çağıran kullanıcı karşılaştırılır.
+// Yetki yoksa yanıtı yazar ve false döner.
+func (h *Handler) authorizeBlog(c *gin.Context, permission configs.Permission, blogID uuid.UUID) bool {
+	role := c.MustGet("role").(types.Role)
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	ownerID := uuid.Nil
+	if configs.GetAccess(role, permission) == configs.AccessOwn {
+		var err error
+		ownerID, err = h.BlogRepository.SelectBlogOwnerID(blogID)
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{
+				"success": false,
+				"error":   "blog_not_found",
+				"message": "Blog yazısı bulunamadı.",
+			})
+			return false
+		}
+	}
+
+	if !configs.CheckPermission(role, permission, userID.String(), ownerID.String()) {
+		utils.Forbidden(c, "Bu blog yazısı üzerinde işlem yapma yetkiniz yok.")
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,26 @@
+package BlogHandler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// renderContentHTML içeriğin HTML'ini editör JSON'ından üretir; istemcinin gönderdiği HTML kullanılmaz.
+// JSON geçersizse 400 döner ve false verir.
+func renderContentHTML(c *gin.Context, content *types.ContentInput) bool {
+	html, err := utils.RenderTipTapHTML(content.JSON)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_content_json",
+			"message": "İçerik JSON'ı geçerli bir editör dokümanı değil.",
+		})
+		return false
+	}
+
+	content.HTML = html
+	return true
+}
@@ -0,0 +1,123 @@
+package BlogHandler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// ScheduleBlogPost blogu ileri bir tarihte yayınlanmak üzere zamanlar veya mevcut zamanlamayı değiştirir
+func (h *Handler) ScheduleBlogPost(c *gin.Context) {
+	var request types.BlogScheduleInput
+
+	err := utils.ValidateRequest(c, &request)
+	if err != nil {
+		return
+	}
+
+	blogID, err := uuid.Parse(request.ID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_id",
+			"message": "Geçersiz blog ID formatı.",
+		})
+		return
+	}
+
+	if !h.authorizeBlog(c, configs.EditPost, blogID) {
+		return
+	}
+
+	if !validateScheduledAt(c, &request.ScheduledAt) {
+		return
+	}
+
+	before, _ := h.BlogRepository.SelectBlogByID(blogID)
+
+	err = h.BlogRepository.ScheduleBlogPost(blogID, request.ScheduledAt)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "schedule_failed",
+			"message": "Blog yazısı zamanlanamadı. Yayında veya silinmiş yazılar zamanlanamaz.",
+		})
+		return
+	}
+
+	h.BlogCache.InvalidateAllBlogs()
+	h.Audit.Record(c, types.AuditBlogSchedule, types.AuditTargetBlog, blogID.String(), blogStatusSnapshot(before),
+		gin.H{"status": types.BlogStatusScheduled, "scheduledAt": request.ScheduledAt})
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":     true,
+		"message":     "Blog yazısı başarıyla zamanlandı.",
+		"scheduledAt": request.ScheduledAt,
+	})
+}
+
+// CancelScheduledBlogPost zamanlanmış bir blogu iptal eder ve taslak durumuna alır
+func (h *Handler) CancelScheduledBlogPost(c *gin.Context) {
+	blogID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_id",
+			"message": "Geçersiz blog ID formatı.",
+		})
+		return
+	}
+
+	if !h.authorizeBlog(c, configs.EditPost, blogID) {
+		return
+	}
+
+	before, _ := h.BlogRepository.SelectBlogByID(blogID)
+
+	err = h.BlogRepository.CancelScheduledBlogPost(blogID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "scheduled_blog_not_found",
+			"message": "Zamanlanmış blog yazısı bulunamadı.",
+		})
+		return
+	}
+
+	h.BlogCache.InvalidateAllBlogs()
+	h.Audit.Record(c, types.AuditBlogScheduleCancel, types.AuditTargetBlog, blogID.String(), blogStatusSnapshot(before),
+		gin.H{"status": types.BlogStatusDraft})
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Zamanlama iptal edildi, blog yazısı taslağa alındı.",
+	})
+}
+
+// validateScheduledAt zamanlama tarihinin gelecekte olduğunu doğrular
+func validateScheduledAt(c *gin.Context, scheduledAt *time.Time) bool {
+	if scheduledAt == nil || scheduledAt.IsZero() {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "missing_scheduled_at",
+			"message": "Zamanlanmış yazılar için scheduledAt alanı gereklidir.",
+		})
+		return false
+	}
+
+	if !scheduledAt.After(time.Now()) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_scheduled_at",
+			"message": "Zamanlama tarihi gelecekte olmalıdır.",
+		})
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,33 @@
+package BlogHandler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// TrackSearchClick arama sonucundaki bir bloga tıklanmasını kaydeder.
+// searchId, /blog/search veya /blog/cards yanıtındaki searchId alanıdır.
+func (h *Handler) TrackSearchClick(c *gin.Context) {
+	var request types.SearchClickInput
+	if err := utils.ValidateRequest(c, &request); err != nil {
+		return
+	}
+
+	tracked := h.Analytics.RecordClick(request.SearchID, request.BlogID)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"tracked": tracked,
+	})
+}
+
+// withSearchID arama kaydedildiyse tıklama takibi için searchId'yi yanıta ekler
+func withSearchID(response gin.H, searchID uuid.UUID) {
+	if searchID != uuid.Nil {
+		response["searchId"] = searchID
+	}
+}
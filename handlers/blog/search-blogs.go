@@ -0,0 +1,71 @@
+package BlogHandler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// SearchBlogs yayınlanmış bloglarda puanlı arama yapar (?q=&language=&category=&tag=&limit=&offset=).
+// Sonuçlar vurgulanmış parçalar, kategori/etiket/dil facet'leri ve toplam sayı ile döner.
+func (h *Handler) SearchBlogs(c *gin.Context) {
+	query := strings.TrimSpace(c.Query("q"))
+	if utf8.RuneCountInString(query) < configs.BLOG_SEARCH_MIN_QUERY_LENGTH {
+		utils.BadRequest(c, fmt.Sprintf("Arama sorgusu en az %d karakter olmalıdır.", configs.BLOG_SEARCH_MIN_QUERY_LENGTH))
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(configs.BLOG_SEARCH_DEFAULT_LIMIT)))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	if limit <= 0 || limit > configs.BLOG_SEARCH_MAX_LIMIT {
+		limit = configs.BLOG_SEARCH_DEFAULT_LIMIT
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	options := types.BlogSearchOptions{
+		Query:    query,
+		Language: c.Query("language"),
+		Category: c.Query("category"),
+		Tag:      c.Query("tag"),
+		Limit:    limit,
+		Offset:   offset,
+	}
+
+	page, cached := h.BlogCache.GetBlogSearch(options)
+	if !cached {
+		var err error
+		page, err = h.BlogRepository.SearchBlogs(options)
+		if err != nil {
+			utils.HandleDatabaseError(c, err, "Blog arama")
+			return
+		}
+
+		h.BlogCache.SaveBlogSearch(options, page)
+	}
+
+	response := gin.H{
+		"success": true,
+		"results": page.Results,
+		"count":   len(page.Results),
+		"total":   page.Total,
+		"facets":  page.Facets,
+		"cached":  cached,
+	}
+
+	// Sonraki sayfalar aynı aramanın devamı sayılır, yalnızca ilk sayfa kaydedilir
+	if offset == 0 {
+		withSearchID(response, h.Analytics.RecordSearch(query, options.Language, types.SearchSourceSearch, page.Total))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -1,14 +1,19 @@
 package BlogHandler
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
 )
 
+// SelectBlogCards blog kartlarını filtreleyerek listeler. Sayfalama ?limit=&offset= veya yanıttaki
+// nextCursor/prevCursor değerlerinden biriyle ?cursor= ile yapılır; cursor ile aynı sortBy/sortDirection gönderilmelidir.
 func (h *Handler) SelectBlogCards(c *gin.Context) {
 	// Query parametrelerini al
 	queryOptions := types.BlogCardQueryOptions{
@@ -44,6 +49,34 @@ func (h *Handler) SelectBlogCards(c *gin.Context) {
 		queryOptions.TagValue = tag
 	}
 
+	// Çoklu değerli filtreler (?categories=a,b veya ?categories=a&categories=b)
+	queryOptions.CategoryValues = queryList(c, "categories")
+	queryOptions.TagValues = queryList(c, "tags")
+	queryOptions.ExcludeCategoryValues = queryList(c, "excludeCategories")
+
+	// Author
+	if authorStr := c.Query("author"); authorStr != "" {
+		authorID, err := uuid.Parse(authorStr)
+		if err != nil {
+			utils.BadRequest(c, "Geçersiz author formatı.")
+			return
+		}
+		queryOptions.AuthorID = &authorID
+	}
+
+	// Okuma süresi aralığı (dakika)
+	var ok bool
+	if queryOptions.MinReadTime, ok = queryMinutes(c, "minReadTime"); !ok {
+		return
+	}
+	if queryOptions.MaxReadTime, ok = queryMinutes(c, "maxReadTime"); !ok {
+		return
+	}
+	if queryOptions.MinReadTime != nil && queryOptions.MaxReadTime != nil && *queryOptions.MinReadTime > *queryOptions.MaxReadTime {
+		utils.BadRequest(c, "minReadTime değeri maxReadTime değerinden büyük olamaz.")
+		return
+	}
+
 	// Featured
 	if featured := c.Query("featured"); featured == "true" {
 		queryOptions.Featured = true
@@ -68,6 +101,17 @@ func (h *Handler) SelectBlogCards(c *gin.Context) {
 		}
 	}
 
+	// Cursor (verilirse offset yerine keyset sayfalama kullanılır)
+	if cursorStr := c.Query("cursor"); cursorStr != "" {
+		cursor, err := utils.DecodeBlogCardCursor(cursorStr)
+		if err != nil {
+			respondInvalidCursor(c)
+			return
+		}
+		queryOptions.Cursor = cursor
+		queryOptions.Offset = 0
+	}
+
 	// SortBy
 	if sortBy := c.Query("sortBy"); sortBy != "" {
 		queryOptions.SortBy = sortBy
@@ -87,37 +131,89 @@ func (h *Handler) SelectBlogCards(c *gin.Context) {
 	}
 
 	// Cache'den blog kartlarını kontrol et
-	blogs, total, exists := h.BlogCache.GetBlogCards(queryOptions)
-	if exists {
-		c.JSON(http.StatusOK, gin.H{
-			"success": true,
-			"blogs":   blogs,
-			"count":   len(blogs),
-			"total":   total, // Toplam kayıt sayısı (filtreleme sonrası)
-			"cached":  true,
-		})
-		return
+	page, cached := h.BlogCache.GetBlogCards(queryOptions)
+	if !cached {
+		// Repository fonksiyonunu çağır
+		var err error
+		page, err = h.BlogRepository.SelectBlogCards(queryOptions)
+		if errors.Is(err, utils.ErrInvalidCursor) {
+			respondInvalidCursor(c)
+			return
+		}
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"success": false,
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		// Blog kartlarını cache'e kaydet
+		h.BlogCache.SaveBlogCards(queryOptions, page)
 	}
 
-	// Repository fonksiyonunu çağır
-	blogs, total, err := h.BlogRepository.SelectBlogCards(queryOptions)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"success": false,
-			"error":   err.Error(),
-		})
-		return
+	// Sonuçları, toplam sayısını ve sayfa cursor'larını döndür
+	response := gin.H{
+		"success":    true,
+		"blogs":      page.Blogs,
+		"count":      len(page.Blogs),
+		"total":      page.Total, // Toplam kayıt sayısı (filtreleme sonrası)
+		"nextCursor": nullableCursor(page.NextCursor),
+		"prevCursor": nullableCursor(page.PrevCursor),
+		"cached":     cached,
 	}
 
-	// Blog kartlarını cache'e kaydet
-	h.BlogCache.SaveBlogCards(queryOptions, blogs, total)
+	// Başlık araması yalnızca ziyaretçi listelerinde (yayınlanmış) ve ilk sayfada kaydedilir
+	isPublicListing := queryOptions.Status == "" || queryOptions.Status == types.BlogStatusPublished
+	isFirstPage := queryOptions.Offset == 0 && queryOptions.Cursor == nil
+	if queryOptions.Title != "" && isFirstPage && isPublicListing {
+		withSearchID(response, h.Analytics.RecordSearch(queryOptions.Title, queryOptions.Language, types.SearchSourceCards, page.Total))
+	}
 
-	// Sonuçları ve toplam sayısını döndür
-	c.JSON(http.StatusOK, gin.H{
-		"success": true,
-		"blogs":   blogs,
-		"count":   len(blogs),
-		"total":   total, // Toplam kayıt sayısı (filtreleme sonrası)
-		"cached":  false,
+	c.JSON(http.StatusOK, response)
+}
+
+// queryList tekrarlanan ve virgülle ayrılmış query parametrelerini tek listede toplar
+func queryList(c *gin.Context, key string) []string {
+	var values []string
+	for _, raw := range c.QueryArray(key) {
+		for _, value := range strings.Split(raw, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
+
+// queryMinutes negatif olmayan dakika değerini okur; boşsa nil döner
+func queryMinutes(c *gin.Context, key string) (*int, bool) {
+	value := c.Query(key)
+	if value == "" {
+		return nil, true
+	}
+
+	minutes, err := strconv.Atoi(value)
+	if err != nil || minutes < 0 {
+		utils.BadRequest(c, "Geçersiz "+key+" değeri.")
+		return nil, false
+	}
+
+	return &minutes, true
+}
+
+func respondInvalidCursor(c *gin.Context) {
+	c.JSON(http.StatusBadRequest, gin.H{
+		"success": false,
+		"error":   "invalid_cursor",
+		"message": "Geçersiz veya farklı bir sıralamaya ait cursor.",
 	})
 }
+
+// nullableCursor son/ilk sayfada cursor'ı null olarak döndürür
+func nullableCursor(cursor string) any {
+	if cursor == "" {
+		return nil
+	}
+	return cursor
+}
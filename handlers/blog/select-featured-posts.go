@@ -29,7 +29,7 @@ func (h *Handler) SelectFeaturedPosts(c *gin.Context) {
 		SortDirection: types.SortDesc,
 	}
 
-	blogs, _, err := h.BlogRepository.SelectBlogCards(queryOptions)
+	page, err := h.BlogRepository.SelectBlogCards(queryOptions)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
@@ -38,6 +38,8 @@ func (h *Handler) SelectFeaturedPosts(c *gin.Context) {
 		return
 	}
 
+	blogs = page.Blogs
+
 	// Öne çıkan yazıları cache'e kaydet
 	h.BlogCache.SaveFeaturedPosts(blogs)
 
@@ -34,7 +34,7 @@ func (h *Handler) SelectRecentPosts(c *gin.Context) {
 		SortDirection: types.SortDesc,
 		Language:      language,
 	}
-	blogs, _, err := h.BlogRepository.SelectBlogCards(queryOptions)
+	page, err := h.BlogRepository.SelectBlogCards(queryOptions)
 
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
@@ -44,6 +44,8 @@ func (h *Handler) SelectRecentPosts(c *gin.Context) {
 		return
 	}
 
+	blogs = page.Blogs
+
 	// Son eklenen yazıları cache'e kaydet
 	h.BlogCache.SaveRecentPosts(blogs)
 
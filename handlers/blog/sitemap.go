@@ -5,7 +5,9 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
 	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
 )
 
 func (h *Handler) SelectBlogSitemap(c *gin.Context) {
@@ -20,23 +22,33 @@ func (h *Handler) SelectBlogSitemap(c *gin.Context) {
 		return
 	}
 
-	// Tüm yayınlanmış blog yazılarını al
+	// Tüm yayınlanmış blog yazılarını cursor ile parça parça al
 	queryOptions := types.BlogCardQueryOptions{
 		Status:        types.BlogStatusPublished,
 		SortBy:        "updated_at",
 		SortDirection: types.SortDesc,
-		Limit:         99999, // Tüm blog yazılarını almak için yüksek limit
+		Limit:         configs.SITEMAP_BATCH_SIZE,
 	}
 
-	blogs, _, err := h.BlogRepository.SelectBlogCards(queryOptions)
+	var blogs []types.BlogPostCardView
+	for {
+		page, err := h.BlogRepository.SelectBlogCards(queryOptions)
+		if err == nil && page.NextCursor != "" {
+			queryOptions.Cursor, err = utils.DecodeBlogCardCursor(page.NextCursor)
+		}
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"success": false,
+				"error":   "sitemap_generation_failed",
+				"message": "Sitemap verileri alınırken bir hata oluştu: " + err.Error(),
+			})
+			return
+		}
 
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"success": false,
-			"error":   "sitemap_generation_failed",
-			"message": "Sitemap verileri alınırken bir hata oluştu: " + err.Error(),
-		})
-		return
+		blogs = append(blogs, page.Blogs...)
+		if page.NextCursor == "" {
+			break
+		}
 	}
 
 	// Blog yazıları için sitemap girişleri
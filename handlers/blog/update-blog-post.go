@@ -4,6 +4,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
 	"github.com/okanay/backend-blog-guideofdubai/types"
 	"github.com/okanay/backend-blog-guideofdubai/utils"
 )
@@ -16,7 +18,32 @@ func (h *Handler) UpdateBlogPost(c *gin.Context) {
 		return
 	}
 
-	blog, err := h.BlogRepository.UpdateBlogPost(request)
+	blogID, err := uuid.Parse(request.ID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_id",
+			"message": "Geçersiz blog ID formatı.",
+		})
+		return
+	}
+
+	if !h.authorizeBlog(c, configs.EditPost, blogID) {
+		return
+	}
+
+	if request.Status == types.BlogStatusScheduled && !validateScheduledAt(c, request.ScheduledAt) {
+		return
+	}
+
+	if !renderContentHTML(c, &request.Content) {
+		return
+	}
+
+	before, _ := h.BlogRepository.SelectBlogByID(blogID)
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+	blog, err := h.BlogRepository.UpdateBlogPost(request, userID)
 	if err != nil {
 		if utils.HandleDatabaseError(c, err, "Blog yazısı güncelleme") {
 			return
@@ -31,6 +58,7 @@ func (h *Handler) UpdateBlogPost(c *gin.Context) {
 	}
 
 	h.BlogCache.InvalidateAllBlogs()
+	h.Audit.Record(c, types.AuditBlogUpdate, types.AuditTargetBlog, blogID.String(), before, blog)
 
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
@@ -5,6 +5,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
 	"github.com/okanay/backend-blog-guideofdubai/types"
 	"github.com/okanay/backend-blog-guideofdubai/utils"
 )
@@ -28,6 +29,32 @@ func (h *Handler) UpdateBlogStatus(c *gin.Context) {
 		return
 	}
 
+	if !h.authorizeBlog(c, configs.EditPost, blogID) {
+		return
+	}
+
+	// Zamanlama için ayrı endpoint kullanılır (yayın tarihi gerekli)
+	if request.Status == types.BlogStatusScheduled {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "use_schedule_endpoint",
+			"message": "Zamanlama için /blog/status/schedule endpoint'ini kullanın.",
+		})
+		return
+	}
+
+	// Silme ve geri yükleme çöp kutusu üzerinden yapılır (silen kullanıcı ve önceki durum saklanır)
+	if request.Status == types.BlogStatusDeleted {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "use_delete_endpoint",
+			"message": "Silmek için DELETE /blog/:id, geri yüklemek için /blog/trash/:id/restore endpoint'ini kullanın.",
+		})
+		return
+	}
+
+	before, _ := h.BlogRepository.SelectBlogByID(blogID)
+
 	// Blog durumunu güncelle
 	err = h.BlogRepository.UpdateBlogStatus(blogID, request.Status)
 	if err != nil {
@@ -39,6 +66,7 @@ func (h *Handler) UpdateBlogStatus(c *gin.Context) {
 	}
 
 	h.BlogCache.InvalidateAllBlogs()
+	h.Audit.Record(c, types.AuditBlogStatusUpdate, types.AuditTargetBlog, blogID.String(), blogStatusSnapshot(before), gin.H{"status": request.Status})
 
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
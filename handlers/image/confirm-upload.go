@@ -85,6 +85,8 @@ func (h *Handler) ConfirmUpload(c *gin.Context) {
 		return
 	}
 
+	h.Audit.Record(c, types.AuditImageUpload, types.AuditTargetImage, imageID.String(), nil, imageInput)
+
 	// Başarılı yanıt döndür
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
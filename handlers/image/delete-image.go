@@ -6,6 +6,9 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
 )
 
 // DeleteImage bir resmi siler
@@ -22,8 +25,9 @@ func (h *Handler) DeleteImage(c *gin.Context) {
 		return
 	}
 
-	// Kullanıcı ID'sini al
+	// Kullanıcı bilgilerini al
 	userID := c.MustGet("user_id").(uuid.UUID)
+	role := c.MustGet("role").(types.Role)
 
 	// Resim bilgilerini getir
 	image, err := h.ImageRepository.GetImageByID(c.Request.Context(), imageID)
@@ -45,13 +49,9 @@ func (h *Handler) DeleteImage(c *gin.Context) {
 		return
 	}
 
-	// Resmin sahibini kontrol et
-	if image.UserID != userID {
-		c.JSON(http.StatusForbidden, gin.H{
-			"success": false,
-			"error":   "permission_denied",
-			"message": "Bu resmi silme yetkiniz yok",
-		})
+	// Resmin sahibini kontrol et ("own" erişiminde sadece kendi resimleri)
+	if !configs.CheckPermission(role, configs.DeleteImage, userID.String(), image.UserID.String()) {
+		utils.Forbidden(c, "Bu resmi silme yetkiniz yok")
 		return
 	}
 
@@ -67,7 +67,7 @@ func (h *Handler) DeleteImage(c *gin.Context) {
 	}
 
 	// Veritabanından resmi sil
-	err = h.ImageRepository.DeleteImage(c.Request.Context(), imageID, userID)
+	err = h.ImageRepository.DeleteImage(c.Request.Context(), imageID, image.UserID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
@@ -77,6 +77,8 @@ func (h *Handler) DeleteImage(c *gin.Context) {
 		return
 	}
 
+	h.Audit.Record(c, types.AuditImageDelete, types.AuditTargetImage, imageID.String(), image, nil)
+
 	// Başarılı yanıt döndür
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
@@ -4,16 +4,19 @@ package ImageHandler
 import (
 	ImageRepository "github.com/okanay/backend-blog-guideofdubai/repositories/image"
 	R2Repository "github.com/okanay/backend-blog-guideofdubai/repositories/r2"
+	AuditService "github.com/okanay/backend-blog-guideofdubai/services/audit"
 )
 
 type Handler struct {
 	ImageRepository *ImageRepository.Repository
 	R2Repository    *R2Repository.Repository
+	Audit           *AuditService.AuditService
 }
 
-func NewHandler(i *ImageRepository.Repository, r2 *R2Repository.Repository) *Handler {
+func NewHandler(i *ImageRepository.Repository, r2 *R2Repository.Repository, a *AuditService.AuditService) *Handler {
 	return &Handler{
 		ImageRepository: i,
 		R2Repository:    r2,
+		Audit:           a,
 	}
 }
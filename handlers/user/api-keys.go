@@ -0,0 +1,113 @@
+package UserHandler
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// CreateAPIKey makine istemcileri için yeni bir API anahtarı oluşturur.
+// Anahtarın kendisi yalnızca bu yanıtta gösterilir, veritabanında hash'i saklanır.
+func (h *Handler) CreateAPIKey(c *gin.Context) {
+	var request types.APIKeyCreateInput
+	if err := utils.ValidateRequest(c, &request); err != nil {
+		return
+	}
+
+	scopes := []string{}
+	for _, scope := range request.Scopes {
+		if !configs.IsValidAPIScope(scope) {
+			utils.BadRequest(c, fmt.Sprintf("Geçersiz kapsam: %s", scope))
+			return
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	count, err := h.TokenRepository.CountActiveAPIKeys(userID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "API anahtarı sayımı")
+		return
+	}
+
+	if count >= configs.API_KEY_MAX_PER_USER {
+		utils.BadRequest(c, fmt.Sprintf("En fazla %d aktif API anahtarı oluşturabilirsiniz.", configs.API_KEY_MAX_PER_USER))
+		return
+	}
+
+	var expiresAt *time.Time
+	if request.ExpiresInDays != nil {
+		expiry := time.Now().AddDate(0, 0, *request.ExpiresInDays)
+		expiresAt = &expiry
+	}
+
+	apiKey, prefix := utils.GenerateAPIKey()
+	key, err := h.TokenRepository.CreateAPIKey(userID, request.Name, prefix, utils.HashToken(apiKey), scopes, expiresAt)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "API anahtarı oluşturma")
+		return
+	}
+
+	h.Audit.Record(c, types.AuditAPIKeyCreate, types.AuditTargetAPIKey, key.ID.String(), nil, key)
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "API anahtarı oluşturuldu. Anahtar bir daha gösterilmeyecek, güvenli bir yerde saklayın.",
+		"key":     apiKey,
+		"apiKey":  key,
+	})
+}
+
+// GetAPIKeys kullanıcının API anahtarlarını listeler
+func (h *Handler) GetAPIKeys(c *gin.Context) {
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	keys, err := h.TokenRepository.SelectAPIKeysByUserID(userID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "API anahtarı listeleme")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":         true,
+		"apiKeys":         keys,
+		"count":           len(keys),
+		"availableScopes": configs.APIScopes,
+	})
+}
+
+// RevokeAPIKey kullanıcının bir API anahtarını iptal eder
+func (h *Handler) RevokeAPIKey(c *gin.Context) {
+	keyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_id",
+			"message": "Geçersiz API anahtarı ID formatı.",
+		})
+		return
+	}
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+	if err := h.TokenRepository.RevokeAPIKey(userID, keyID); err != nil {
+		utils.NotFound(c, "API anahtarı")
+		return
+	}
+
+	h.Audit.Record(c, types.AuditAPIKeyRevoke, types.AuditTargetAPIKey, keyID.String(), nil, gin.H{"revoked": true})
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "API anahtarı iptal edildi.",
+	})
+}
@@ -0,0 +1,61 @@
+package UserHandler
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+func (h *Handler) ChangePassword(c *gin.Context) {
+	var request types.ChangePasswordRequest
+
+	err := utils.ValidateRequest(c, &request)
+	if err != nil {
+		return
+	}
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+	user, err := h.UserRepository.SelectByID(userID)
+	if err != nil {
+		utils.NotFound(c, "Kullanıcı")
+		return
+	}
+
+	// Mevcut şifreyi doğrula
+	if !utils.CheckPassword(request.CurrentPassword, user.HashedPassword) {
+		utils.Unauthorized(c, "Mevcut şifre hatalı.")
+		return
+	}
+
+	if request.CurrentPassword == request.NewPassword {
+		utils.BadRequest(c, "Yeni şifre mevcut şifre ile aynı olamaz.")
+		return
+	}
+
+	err = h.UserRepository.UpdatePassword(user.Email, request.NewPassword)
+	if err != nil {
+		if utils.HandleDatabaseError(c, err, "Şifre güncelleme") {
+			return
+		}
+		return
+	}
+
+	// Mevcut oturum korunur, diğer cihazlardaki oturumlar sonlandırılır
+	currentToken, _ := utils.CurrentRefreshToken(c)
+	err = h.TokenRepository.RevokeOtherUserTokens(user.ID, currentToken, "Password changed")
+	if err != nil {
+		// Şifre değişti; oturum iptali başarısız olsa da işlem tamamlanmış sayılır
+		log.Printf("[TOKEN]: Şifre değişikliği sonrası oturumlar sonlandırılamadı: %v", err)
+	}
+	h.SessionCache.InvalidateUserSessions(user.ID)
+	h.Audit.Record(c, types.AuditPasswordChange, types.AuditTargetUser, user.ID.String(), nil, nil)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Şifreniz başarıyla güncellendi.",
+	})
+}
@@ -0,0 +1,125 @@
+package UserHandler
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	"github.com/okanay/backend-blog-guideofdubai/services/mailer"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// VerifyEmail e-postadaki token ile kullanıcının e-posta adresini doğrular
+func (h *Handler) VerifyEmail(c *gin.Context) {
+	var request types.VerifyEmailRequest
+
+	err := utils.ValidateRequest(c, &request)
+	if err != nil {
+		return
+	}
+
+	userID, err := h.UserRepository.VerifyEmailWithToken(utils.HashToken(request.Token))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_verification_token",
+			"message": "Doğrulama bağlantısı geçersiz veya süresi dolmuş.",
+		})
+		return
+	}
+
+	// Access token içindeki emailVerified bilgisinin bir sonraki istekte yenilenmesi için
+	h.SessionCache.InvalidateUserSessions(userID)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "E-posta adresiniz doğrulandı.",
+	})
+}
+
+// ResendVerificationEmail doğrulama e-postasını yeniden gönderir (bekleme süresi ve günlük limit uygulanır)
+func (h *Handler) ResendVerificationEmail(c *gin.Context) {
+	userID := c.MustGet("user_id").(uuid.UUID)
+	user, err := h.UserRepository.SelectByID(userID)
+	if err != nil {
+		utils.NotFound(c, "Kullanıcı")
+		return
+	}
+
+	if user.EmailVerified {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "email_already_verified",
+			"message": "E-posta adresiniz zaten doğrulanmış.",
+		})
+		return
+	}
+
+	count, lastSentAt, err := h.UserRepository.SelectEmailVerificationStats(user.ID, time.Now().Add(-24*time.Hour))
+	if err != nil {
+		if utils.HandleDatabaseError(c, err, "Doğrulama e-postası gönderme") {
+			return
+		}
+		return
+	}
+
+	if lastSentAt != nil {
+		if wait := configs.EMAIL_VERIFICATION_RESEND_COOLDOWN - time.Since(*lastSentAt); wait > 0 {
+			seconds := int(wait.Seconds()) + 1
+			c.Header("Retry-After", strconv.Itoa(seconds))
+			c.JSON(http.StatusTooManyRequests, gin.H{
+				"success":    false,
+				"error":      "resend_too_soon",
+				"message":    fmt.Sprintf("Yeni bir doğrulama e-postası için %d saniye beklemelisiniz.", seconds),
+				"retryAfter": seconds,
+			})
+			return
+		}
+	}
+
+	if count >= configs.EMAIL_VERIFICATION_RESEND_DAILY_MAX {
+		c.JSON(http.StatusTooManyRequests, gin.H{
+			"success": false,
+			"error":   "resend_limit_exceeded",
+			"message": "Günlük doğrulama e-postası limitine ulaştınız. Lütfen daha sonra tekrar deneyin.",
+		})
+		return
+	}
+
+	if err := h.sendVerificationEmail(user); err != nil {
+		if utils.HandleDatabaseError(c, err, "Doğrulama e-postası gönderme") {
+			return
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Doğrulama e-postası gönderildi.",
+	})
+}
+
+// sendVerificationEmail yeni bir doğrulama tokenı üretir ve e-posta ile gönderir.
+// Token kaydedilemezse hata döner; e-posta gönderim hataları sadece loglanır.
+func (h *Handler) sendVerificationEmail(user types.User) error {
+	token := utils.GenerateRandomString(configs.EMAIL_VERIFICATION_TOKEN_LENGTH)
+	expiresAt := time.Now().Add(configs.EMAIL_VERIFICATION_TOKEN_DURATION)
+
+	err := h.UserRepository.CreateEmailVerificationToken(user.ID, utils.HashToken(token), expiresAt)
+	if err != nil {
+		return err
+	}
+
+	err = h.Mailer.Send(mailer.EmailVerificationMessage(user.Email, user.Username, token))
+	if err != nil {
+		log.Printf("[MAILER]: Doğrulama e-postası gönderilemedi: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package UserHandler
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	"github.com/okanay/backend-blog-guideofdubai/services/mailer"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+func (h *Handler) ForgotPassword(c *gin.Context) {
+	var request types.ForgotPasswordRequest
+
+	err := utils.ValidateRequest(c, &request)
+	if err != nil {
+		return
+	}
+
+	// E-postanın kayıtlı olup olmadığı dışarı sızdırılmaz, yanıt her durumda aynıdır
+	response := gin.H{
+		"success": true,
+		"message": "E-posta adresi kayıtlıysa şifre sıfırlama bağlantısı gönderildi.",
+	}
+
+	user, err := h.UserRepository.SelectByEmail(request.Email)
+	if err != nil || user.Status != types.UserStatusActive {
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	token := utils.GenerateRandomString(configs.PASSWORD_RESET_TOKEN_LENGTH)
+	expiresAt := time.Now().Add(configs.PASSWORD_RESET_TOKEN_DURATION)
+
+	err = h.UserRepository.CreatePasswordResetToken(user.ID, utils.HashToken(token), utils.GetTrueClientIP(c), expiresAt)
+	if err != nil {
+		if utils.HandleDatabaseError(c, err, "Şifre sıfırlama") {
+			return
+		}
+		return
+	}
+
+	err = h.Mailer.Send(mailer.PasswordResetMessage(user.Email, user.Username, token))
+	if err != nil {
+		log.Printf("[MAILER]: Şifre sıfırlama e-postası gönderilemedi: %v", err)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
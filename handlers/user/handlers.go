@@ -3,16 +3,29 @@ package UserHandler
 import (
 	TokenRepository "github.com/okanay/backend-blog-guideofdubai/repositories/token"
 	UserRepository "github.com/okanay/backend-blog-guideofdubai/repositories/user"
+	AuditService "github.com/okanay/backend-blog-guideofdubai/services/audit"
+	"github.com/okanay/backend-blog-guideofdubai/services/cache"
+	"github.com/okanay/backend-blog-guideofdubai/services/mailer"
 )
 
 type Handler struct {
-	UserRepository  *UserRepository.Repository
-	TokenRepository *TokenRepository.Repository
+	UserRepository      *UserRepository.Repository
+	TokenRepository     *TokenRepository.Repository
+	Mailer              mailer.Mailer
+	SessionCache        *cache.SessionCacheService
+	LoginAttempts       *cache.LoginAttemptCacheService
+	TwoFactorChallenges *cache.TwoFactorChallengeCacheService
+	Audit               *AuditService.AuditService
 }
 
-func NewHandler(u *UserRepository.Repository, t *TokenRepository.Repository) *Handler {
+func NewHandler(u *UserRepository.Repository, t *TokenRepository.Repository, m mailer.Mailer, s *cache.SessionCacheService, la *cache.LoginAttemptCacheService, tf *cache.TwoFactorChallengeCacheService, a *AuditService.AuditService) *Handler {
 	return &Handler{
-		UserRepository:  u,
-		TokenRepository: t,
+		UserRepository:      u,
+		TokenRepository:     t,
+		Mailer:              m,
+		SessionCache:        s,
+		LoginAttempts:       la,
+		TwoFactorChallenges: tf,
+		Audit:               a,
 	}
 }
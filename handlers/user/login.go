@@ -1,7 +1,9 @@
 package UserHandler
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -19,16 +21,23 @@ func (h *Handler) Login(c *gin.Context) {
 		return
 	}
 
+	// Brute-force koruması: kilitli kullanıcı adı veya IP için şifre kontrolü yapılmaz
+	clientIP := utils.GetTrueClientIP(c)
+	if retryAfter, locked := h.LoginAttempts.CheckLockout(request.Username, clientIP); locked {
+		respondLoginLocked(c, retryAfter)
+		return
+	}
+
 	// Retrieve user information from the database
 	user, err := h.UserRepository.SelectByUsername(request.Username)
 	if err != nil {
-		utils.Unauthorized(c, "Geçersiz kullanıcı adı veya şifre.")
+		h.handleLoginFailure(c, request.Username, clientIP)
 		return
 	}
 
 	// Validate password
 	if !utils.CheckPassword(request.Password, user.HashedPassword) {
-		utils.Unauthorized(c, "Geçersiz kullanıcı adı veya şifre.")
+		h.handleLoginFailure(c, request.Username, clientIP)
 		return
 	}
 
@@ -48,19 +57,45 @@ func (h *Handler) Login(c *gin.Context) {
 		return
 	}
 
+	// İkinci adım gerekiyorsa oturum açılmaz, yalnızca kısa ömürlü bir challenge döner
+	if h.requireSecondFactor(c, user) {
+		return
+	}
+
+	// Sayaç yalnızca tüm doğrulama adımları tamamlandığında sıfırlanır
+	h.LoginAttempts.RegisterSuccess(request.Username)
+
+	userProfile, ok := h.issueSession(c, user)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Giriş başarılı.",
+		"user":    userProfile,
+	})
+}
+
+// issueSession kullanıcı için access/refresh tokenları oluşturup çerezlere yazar
+func (h *Handler) issueSession(c *gin.Context, user types.User) (types.UserView, bool) {
 	// Token işlemleri...
 	tokenClaims := types.TokenClaims{
-		ID:       user.ID,
-		Username: user.Username,
-		Email:    user.Email,
-		Role:     user.Role,
+		ID:            user.ID,
+		Username:      user.Username,
+		Email:         user.Email,
+		Role:          user.Role,
+		EmailVerified: user.EmailVerified,
+		Status:        user.Status,
+		CreatedAt:     user.CreatedAt,
+		LastLogin:     time.Now(),
 	}
 
 	// Generate access token
 	accessToken, err := utils.GenerateAccessToken(tokenClaims)
 	if err != nil {
 		utils.SendError(c, "token_generation_failed", "Oturum oluşturulurken bir hata oluştu.")
-		return
+		return types.UserView{}, false
 	}
 
 	// Generate refresh token
@@ -82,10 +117,8 @@ func (h *Handler) Login(c *gin.Context) {
 
 	_, err = h.TokenRepository.CreateRefreshToken(tokenRequest)
 	if err != nil {
-		if utils.HandleDatabaseError(c, err, "Token kaydetme") {
-			return
-		}
-		return
+		utils.HandleDatabaseError(c, err, "Token kaydetme")
+		return types.UserView{}, false
 	}
 
 	// Update user's last login time
@@ -131,9 +164,27 @@ func (h *Handler) Login(c *gin.Context) {
 		LastLogin:     now, // Newly updated login time
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"success": true,
-		"message": "Giriş başarılı.",
-		"user":    userProfile,
+	return userProfile, true
+}
+
+// handleLoginFailure başarısız denemeyi kaydeder, deneme kilide yol açtıysa 429 döner
+func (h *Handler) handleLoginFailure(c *gin.Context, username string, ip string) {
+	if retryAfter, locked := h.LoginAttempts.RegisterFailure(username, ip); locked {
+		respondLoginLocked(c, retryAfter)
+		return
+	}
+
+	utils.Unauthorized(c, "Geçersiz kullanıcı adı veya şifre.")
+}
+
+func respondLoginLocked(c *gin.Context, retryAfter time.Duration) {
+	seconds := int(retryAfter.Seconds()) + 1
+
+	c.Header("Retry-After", strconv.Itoa(seconds))
+	c.JSON(http.StatusTooManyRequests, gin.H{
+		"success":    false,
+		"error":      "too_many_login_attempts",
+		"message":    fmt.Sprintf("Çok fazla başarısız giriş denemesi. Lütfen %d saniye sonra tekrar deneyin.", seconds),
+		"retryAfter": seconds,
 	})
 }
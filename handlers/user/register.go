@@ -2,6 +2,7 @@ package UserHandler
 
 import (
 	"errors"
+	"log"
 	"net/http"
 	"strings"
 
@@ -56,6 +57,11 @@ func (h *Handler) Register(c *gin.Context) {
 		return
 	}
 
+	// Doğrulama e-postası gönderilemese de kayıt tamamlanır, kullanıcı yeniden gönderim isteyebilir
+	if err := h.sendVerificationEmail(user); err != nil {
+		log.Printf("[USER]: Doğrulama tokenı oluşturulamadı: %v", err)
+	}
+
 	c.JSON(http.StatusCreated, gin.H{
 		"success": true,
 		"message": "User created successfully.",
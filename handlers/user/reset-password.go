@@ -0,0 +1,46 @@
+package UserHandler
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+func (h *Handler) ResetPassword(c *gin.Context) {
+	var request types.ResetPasswordRequest
+
+	err := utils.ValidateRequest(c, &request)
+	if err != nil {
+		return
+	}
+
+	userID, err := h.UserRepository.ResetPasswordWithToken(utils.HashToken(request.Token), request.NewPassword)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_reset_token",
+			"message": "Şifre sıfırlama bağlantısı geçersiz veya süresi dolmuş.",
+		})
+		return
+	}
+
+	// Şifre sıfırlandığında tüm oturumlar sonlandırılır
+	err = h.TokenRepository.RevokeAllUserTokens(userID, "Password reset")
+	if err != nil {
+		// Şifre değişti; oturum iptali başarısız olsa da işlem tamamlanmış sayılır
+		log.Printf("[TOKEN]: Şifre sıfırlama sonrası oturumlar sonlandırılamadı: %v", err)
+	}
+	if err := h.TokenRepository.RevokeAllUserAPIKeys(userID); err != nil {
+		log.Printf("[API KEY]: Şifre sıfırlama sonrası API anahtarları iptal edilemedi: %v", err)
+	}
+	h.SessionCache.InvalidateUserSessions(userID)
+	h.Audit.Record(c, types.AuditPasswordReset, types.AuditTargetUser, userID.String(), nil, nil)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Şifreniz başarıyla sıfırlandı. Yeni şifrenizle giriş yapabilirsiniz.",
+	})
+}
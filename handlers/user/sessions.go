@@ -0,0 +1,108 @@
+package UserHandler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// GetSessions kullanıcının aktif oturumlarını (cihazlarını) listeler
+func (h *Handler) GetSessions(c *gin.Context) {
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	tokens, err := h.TokenRepository.SelectActiveTokensByUserID(userID)
+	if err != nil {
+		if utils.HandleDatabaseError(c, err, "Oturum listeleme") {
+			return
+		}
+		return
+	}
+
+	currentToken, _ := utils.CurrentRefreshToken(c)
+	sessions := types.NewSessionViews(tokens, currentToken)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"sessions": sessions,
+		"count":    len(sessions),
+	})
+}
+
+// RevokeSession kullanıcının tek bir oturumunu sonlandırır (id: oturum listesindeki family ID)
+func (h *Handler) RevokeSession(c *gin.Context) {
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	sessionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_id",
+			"message": "Geçersiz oturum ID formatı.",
+		})
+		return
+	}
+
+	// Sonlandırılan oturumun mevcut oturum olup olmadığını kontrol et
+	isCurrent := false
+	if currentToken, ok := utils.CurrentRefreshToken(c); ok {
+		if dbToken, err := h.TokenRepository.SelectRefreshTokenByToken(currentToken); err == nil {
+			isCurrent = dbToken.FamilyID == sessionID
+		}
+	}
+
+	err = h.TokenRepository.RevokeUserSession(userID, sessionID, "Revoked by user")
+	if err != nil {
+		utils.NotFound(c, "Oturum")
+		return
+	}
+
+	// Sonlandırılan cihazın access token'ı da bir sonraki istekte geçersiz olur
+	h.SessionCache.InvalidateUserSessions(userID)
+
+	// Mevcut oturum sonlandırıldıysa çerezler de temizlenir
+	if isCurrent {
+		clearSessionCookies(c)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Oturum sonlandırıldı.",
+		"current": isCurrent,
+	})
+}
+
+// RevokeOtherSessions mevcut oturum dışındaki tüm oturumları sonlandırır
+func (h *Handler) RevokeOtherSessions(c *gin.Context) {
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	currentToken, ok := utils.CurrentRefreshToken(c)
+	if !ok {
+		utils.Unauthorized(c, "Mevcut oturum bulunamadı.")
+		return
+	}
+
+	err := h.TokenRepository.RevokeOtherUserTokens(userID, currentToken, "Logged out from other devices")
+	if err != nil {
+		if utils.HandleDatabaseError(c, err, "Oturum sonlandırma") {
+			return
+		}
+		return
+	}
+
+	h.SessionCache.InvalidateUserSessions(userID)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Diğer tüm oturumlar sonlandırıldı.",
+	})
+}
+
+func clearSessionCookies(c *gin.Context) {
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(configs.ACCESS_TOKEN_NAME, "", -1, "/", "", false, true)
+	c.SetCookie(configs.REFRESH_TOKEN_NAME, "", -1, "/", "", false, true)
+}
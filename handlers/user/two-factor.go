@@ -0,0 +1,447 @@
+package UserHandler
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// requireSecondFactor kullanıcının 2FA'sı etkinse veya rolü için zorunluysa challenge oluşturup yanıtı yazar
+func (h *Handler) requireSecondFactor(c *gin.Context, user types.User) bool {
+	twoFactor, err := h.UserRepository.SelectTwoFactor(user.ID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "2FA kontrolü")
+		return true
+	}
+
+	enabled := twoFactor != nil && twoFactor.Enabled
+	required, err := h.UserRepository.IsTwoFactorRequired(user.Role)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "2FA politikası kontrolü")
+		return true
+	}
+
+	if !enabled && !required {
+		return false
+	}
+
+	token, challenge := h.TwoFactorChallenges.Create(user.ID)
+
+	message := "Doğrulama kodunu girin."
+	if !enabled {
+		message = "Rolünüz için iki adımlı doğrulama zorunludur. Devam etmek için kurulumu tamamlayın."
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":           true,
+		"twoFactorRequired": true,
+		"setupRequired":     !enabled,
+		"challengeToken":    token,
+		"expiresAt":         challenge.ExpiresAt,
+		"message":           message,
+	})
+	return true
+}
+
+// LoginTwoFactor girişin ikinci adımıdır; kod doğrulanınca oturum çerezleri oluşturulur.
+// Kurulum giriş sırasında başlatıldıysa ilk doğru kod kurulumu da onaylar ve kurtarma kodlarını döndürür.
+func (h *Handler) LoginTwoFactor(c *gin.Context) {
+	var request types.TwoFactorLoginRequest
+	if err := utils.ValidateRequest(c, &request); err != nil {
+		return
+	}
+
+	if request.Code == "" && request.RecoveryCode == "" {
+		utils.BadRequest(c, "Doğrulama kodu veya kurtarma kodu gereklidir.")
+		return
+	}
+
+	user, twoFactor, ok := h.loadChallengeUser(c, request.ChallengeToken)
+	if !ok {
+		return
+	}
+
+	if twoFactor == nil {
+		utils.BadRequest(c, "İki adımlı doğrulama kurulumu başlatılmamış.")
+		return
+	}
+
+	// İkinci adım da şifreyle aynı brute-force sayaçlarına tabidir
+	clientIP := utils.GetTrueClientIP(c)
+	if retryAfter, locked := h.LoginAttempts.CheckLockout(user.Username, clientIP); locked {
+		respondLoginLocked(c, retryAfter)
+		return
+	}
+
+	var recoveryCodes []string
+	var verified bool
+	var err error
+
+	if twoFactor.Enabled {
+		if request.RecoveryCode != "" {
+			verified, err = h.UserRepository.ConsumeRecoveryCode(user.ID, utils.HashToken(utils.NormalizeRecoveryCode(request.RecoveryCode)))
+		} else {
+			verified, err = h.consumeTOTP(user.ID, twoFactor.Secret, request.Code)
+		}
+	} else if request.Code != "" {
+		// Giriş sırasında başlatılan kurulumun onayı
+		if step, valid := utils.ValidateTOTP(twoFactor.Secret, request.Code, time.Now()); valid {
+			var hashes []string
+			recoveryCodes, hashes = newRecoveryCodes()
+			err = h.UserRepository.EnableTwoFactor(user.ID, step, hashes)
+			verified = err == nil
+		}
+	}
+
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "2FA doğrulama")
+		return
+	}
+
+	if !verified {
+		remaining := h.TwoFactorChallenges.RegisterFailedAttempt(request.ChallengeToken)
+		if retryAfter, locked := h.LoginAttempts.RegisterFailure(user.Username, clientIP); locked {
+			respondLoginLocked(c, retryAfter)
+			return
+		}
+
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success":           false,
+			"error":             "invalid_two_factor_code",
+			"message":           "Doğrulama kodu hatalı.",
+			"remainingAttempts": remaining,
+		})
+		return
+	}
+
+	h.TwoFactorChallenges.Delete(request.ChallengeToken)
+	h.LoginAttempts.RegisterSuccess(user.Username)
+
+	userProfile, ok := h.issueSession(c, *user)
+	if !ok {
+		return
+	}
+
+	response := gin.H{
+		"success": true,
+		"message": "Giriş başarılı.",
+		"user":    userProfile,
+	}
+	if recoveryCodes != nil {
+		response["recoveryCodes"] = recoveryCodes
+		h.Audit.Record(c, types.AuditTwoFactorEnable, types.AuditTargetUser, user.ID.String(), nil, gin.H{"enabled": true, "duringLogin": true})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// LoginTwoFactorSetup 2FA zorunlu olup kurulumu olmayan hesaplar için giriş sırasında secret üretir
+func (h *Handler) LoginTwoFactorSetup(c *gin.Context) {
+	var request types.TwoFactorSetupLoginRequest
+	if err := utils.ValidateRequest(c, &request); err != nil {
+		return
+	}
+
+	user, twoFactor, ok := h.loadChallengeUser(c, request.ChallengeToken)
+	if !ok {
+		return
+	}
+
+	if twoFactor != nil && twoFactor.Enabled {
+		utils.BadRequest(c, "İki adımlı doğrulama zaten etkin.")
+		return
+	}
+
+	h.startTwoFactorSetup(c, *user)
+}
+
+// GetTwoFactorStatus oturumdaki kullanıcının 2FA durumunu döndürür
+func (h *Handler) GetTwoFactorStatus(c *gin.Context) {
+	userID := c.MustGet("user_id").(uuid.UUID)
+	role := c.MustGet("role").(types.Role)
+
+	twoFactor, err := h.UserRepository.SelectTwoFactor(userID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "2FA durumu")
+		return
+	}
+
+	required, err := h.UserRepository.IsTwoFactorRequired(role)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "2FA politikası kontrolü")
+		return
+	}
+
+	status := types.TwoFactorStatus{Required: required}
+	if twoFactor != nil && twoFactor.Enabled {
+		status.Enabled = true
+		status.ConfirmedAt = twoFactor.ConfirmedAt
+
+		status.RemainingRecoveryCodes, err = h.UserRepository.CountRemainingRecoveryCodes(userID)
+		if err != nil {
+			utils.HandleDatabaseError(c, err, "Kurtarma kodu sayımı")
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":   true,
+		"twoFactor": status,
+	})
+}
+
+// SetupTwoFactor oturumdaki kullanıcı için 2FA kurulumunu başlatır
+func (h *Handler) SetupTwoFactor(c *gin.Context) {
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	user, err := h.UserRepository.SelectByID(userID)
+	if err != nil {
+		utils.NotFound(c, "Kullanıcı")
+		return
+	}
+
+	twoFactor, err := h.UserRepository.SelectTwoFactor(userID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "2FA durumu")
+		return
+	}
+
+	if twoFactor != nil && twoFactor.Enabled {
+		utils.BadRequest(c, "İki adımlı doğrulama zaten etkin.")
+		return
+	}
+
+	h.startTwoFactorSetup(c, user)
+}
+
+// ConfirmTwoFactor kurulumu ilk doğru kod ile onaylar ve kurtarma kodlarını bir kez gösterir
+func (h *Handler) ConfirmTwoFactor(c *gin.Context) {
+	var request types.TwoFactorCodeRequest
+	if err := utils.ValidateRequest(c, &request); err != nil {
+		return
+	}
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	twoFactor, err := h.UserRepository.SelectTwoFactor(userID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "2FA durumu")
+		return
+	}
+
+	if twoFactor == nil {
+		utils.BadRequest(c, "İki adımlı doğrulama kurulumu başlatılmamış.")
+		return
+	}
+
+	if twoFactor.Enabled {
+		utils.BadRequest(c, "İki adımlı doğrulama zaten etkin.")
+		return
+	}
+
+	step, valid := utils.ValidateTOTP(twoFactor.Secret, request.Code, time.Now())
+	if !valid {
+		utils.Unauthorized(c, "Doğrulama kodu hatalı.")
+		return
+	}
+
+	recoveryCodes, hashes := newRecoveryCodes()
+	if err := h.UserRepository.EnableTwoFactor(userID, step, hashes); err != nil {
+		utils.HandleDatabaseError(c, err, "2FA etkinleştirme")
+		return
+	}
+
+	h.Audit.Record(c, types.AuditTwoFactorEnable, types.AuditTargetUser, userID.String(), gin.H{"enabled": false}, gin.H{"enabled": true})
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":       true,
+		"message":       "İki adımlı doğrulama etkinleştirildi. Kurtarma kodlarını güvenli bir yerde saklayın.",
+		"recoveryCodes": recoveryCodes,
+	})
+}
+
+// DisableTwoFactor şifre ve geçerli bir kod ile 2FA'yı kapatır (rol için zorunluysa izin verilmez)
+func (h *Handler) DisableTwoFactor(c *gin.Context) {
+	var request types.TwoFactorDisableRequest
+	if err := utils.ValidateRequest(c, &request); err != nil {
+		return
+	}
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	user, err := h.UserRepository.SelectByID(userID)
+	if err != nil {
+		utils.NotFound(c, "Kullanıcı")
+		return
+	}
+
+	required, err := h.UserRepository.IsTwoFactorRequired(user.Role)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "2FA politikası kontrolü")
+		return
+	}
+
+	if required {
+		utils.Forbidden(c, "Rolünüz için iki adımlı doğrulama zorunludur ve kapatılamaz.")
+		return
+	}
+
+	if !utils.CheckPassword(request.Password, user.HashedPassword) {
+		utils.Unauthorized(c, "Şifre hatalı.")
+		return
+	}
+
+	twoFactor, ok := h.verifyEnabledTwoFactor(c, userID, request.Code)
+	if !ok || twoFactor == nil {
+		return
+	}
+
+	if err := h.UserRepository.DisableTwoFactor(userID); err != nil {
+		utils.HandleDatabaseError(c, err, "2FA kapatma")
+		return
+	}
+
+	h.Audit.Record(c, types.AuditTwoFactorDisable, types.AuditTargetUser, userID.String(), gin.H{"enabled": true}, gin.H{"enabled": false})
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "İki adımlı doğrulama kapatıldı.",
+	})
+}
+
+// RegenerateRecoveryCodes geçerli bir TOTP kodu ile tüm kurtarma kodlarını yeniler
+func (h *Handler) RegenerateRecoveryCodes(c *gin.Context) {
+	var request types.TwoFactorCodeRequest
+	if err := utils.ValidateRequest(c, &request); err != nil {
+		return
+	}
+
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	if _, ok := h.verifyEnabledTwoFactor(c, userID, request.Code); !ok {
+		return
+	}
+
+	recoveryCodes, hashes := newRecoveryCodes()
+	if err := h.UserRepository.ReplaceRecoveryCodes(userID, hashes); err != nil {
+		utils.HandleDatabaseError(c, err, "Kurtarma kodu oluşturma")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":       true,
+		"message":       "Kurtarma kodları yenilendi. Eski kodlar artık geçersizdir.",
+		"recoveryCodes": recoveryCodes,
+	})
+}
+
+// loadChallengeUser challenge tokenına ait aktif kullanıcıyı ve 2FA kaydını getirir
+func (h *Handler) loadChallengeUser(c *gin.Context, challengeToken string) (*types.User, *types.UserTwoFactor, bool) {
+	challenge, ok := h.TwoFactorChallenges.Get(challengeToken)
+	if !ok {
+		utils.Unauthorized(c, "Doğrulama oturumu geçersiz veya süresi dolmuş. Lütfen tekrar giriş yapın.")
+		return nil, nil, false
+	}
+
+	user, err := h.UserRepository.SelectByID(challenge.UserID)
+	if err != nil || user.Status != types.UserStatusActive {
+		h.TwoFactorChallenges.Delete(challengeToken)
+		utils.Unauthorized(c, "Hesabınız aktif değil.")
+		return nil, nil, false
+	}
+
+	twoFactor, err := h.UserRepository.SelectTwoFactor(user.ID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "2FA durumu")
+		return nil, nil, false
+	}
+
+	return &user, twoFactor, true
+}
+
+// startTwoFactorSetup yeni bir secret üretip onaylanmamış kurulum olarak kaydeder
+func (h *Handler) startTwoFactorSetup(c *gin.Context, user types.User) {
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		utils.SendError(c, utils.ErrorOperationFailed, "2FA secret oluşturulamadı.")
+		return
+	}
+
+	if err := h.UserRepository.UpsertPendingTwoFactor(user.ID, secret); err != nil {
+		utils.HandleDatabaseError(c, err, "2FA kurulumu")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"message":    "Secret'ı authenticator uygulamanıza ekleyin ve üretilen kod ile kurulumu onaylayın.",
+		"secret":     secret,
+		"otpauthUri": utils.TOTPAuthURI(configs.TWO_FACTOR_ISSUER, user.Email, secret),
+	})
+}
+
+// verifyEnabledTwoFactor etkin 2FA için TOTP veya kurtarma kodunu doğrular, hata durumunda yanıtı yazar
+func (h *Handler) verifyEnabledTwoFactor(c *gin.Context, userID uuid.UUID, code string) (*types.UserTwoFactor, bool) {
+	twoFactor, err := h.UserRepository.SelectTwoFactor(userID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "2FA durumu")
+		return nil, false
+	}
+
+	if twoFactor == nil || !twoFactor.Enabled {
+		utils.BadRequest(c, "İki adımlı doğrulama etkin değil.")
+		return nil, false
+	}
+
+	verified, err := h.consumeTOTP(userID, twoFactor.Secret, code)
+	if err == nil && !verified {
+		verified, err = h.UserRepository.ConsumeRecoveryCode(userID, utils.HashToken(utils.NormalizeRecoveryCode(code)))
+	}
+
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "2FA doğrulama")
+		return nil, false
+	}
+
+	if !verified {
+		utils.Unauthorized(c, "Doğrulama kodu hatalı.")
+		return nil, false
+	}
+
+	return twoFactor, true
+}
+
+// consumeTOTP kodu doğrular ve aynı kodun tekrar kullanılmasını engeller
+func (h *Handler) consumeTOTP(userID uuid.UUID, secret string, code string) (bool, error) {
+	step, valid := utils.ValidateTOTP(secret, code, time.Now())
+	if !valid {
+		return false, nil
+	}
+
+	consumed, err := h.UserRepository.ConsumeTOTPStep(userID, step)
+	if err != nil {
+		return false, err
+	}
+
+	if !consumed {
+		log.Printf("[2FA]: Kullanıcı %s için tekrar kullanılan TOTP kodu reddedildi", userID)
+	}
+
+	return consumed, nil
+}
+
+// newRecoveryCodes kullanıcıya gösterilecek kodları ve veritabanına yazılacak hash'lerini üretir
+func newRecoveryCodes() ([]string, []string) {
+	codes := utils.GenerateRecoveryCodes(configs.TWO_FACTOR_RECOVERY_CODE_COUNT)
+	hashes := make([]string, len(codes))
+	for i, code := range codes {
+		hashes[i] = utils.HashToken(code)
+	}
+	return codes, hashes
+}
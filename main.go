@@ -1,9 +1,14 @@
 package main
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"log"
+	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -22,29 +27,49 @@ import (
 	"github.com/okanay/backend-blog-guideofdubai/middlewares"
 	mw "github.com/okanay/backend-blog-guideofdubai/middlewares"
 	AIRepository "github.com/okanay/backend-blog-guideofdubai/repositories/ai"
+	AnalyticsRepository "github.com/okanay/backend-blog-guideofdubai/repositories/analytics"
+	AuditRepository "github.com/okanay/backend-blog-guideofdubai/repositories/audit"
 	BlogRepository "github.com/okanay/backend-blog-guideofdubai/repositories/blog"
 	ImageRepository "github.com/okanay/backend-blog-guideofdubai/repositories/image"
 	R2Repository "github.com/okanay/backend-blog-guideofdubai/repositories/r2"
 	TokenRepository "github.com/okanay/backend-blog-guideofdubai/repositories/token"
 	UserRepository "github.com/okanay/backend-blog-guideofdubai/repositories/user"
 	AIService "github.com/okanay/backend-blog-guideofdubai/services/ai"
+	AnalyticsService "github.com/okanay/backend-blog-guideofdubai/services/analytics"
+	AuditService "github.com/okanay/backend-blog-guideofdubai/services/audit"
 	"github.com/okanay/backend-blog-guideofdubai/services/cache"
+	"github.com/okanay/backend-blog-guideofdubai/services/mailer"
+	SchedulerService "github.com/okanay/backend-blog-guideofdubai/services/scheduler"
+	TokenService "github.com/okanay/backend-blog-guideofdubai/services/token"
+	TrashService "github.com/okanay/backend-blog-guideofdubai/services/trash"
 )
 
 // Uygulama bileşenlerini gruplamak için yapılar
 type Repositories struct {
-	User  *UserRepository.Repository
-	Token *TokenRepository.Repository
-	Blog  *BlogRepository.Repository
-	AI    *AIRepository.Repository
-	Image *ImageRepository.Repository
-	R2    *R2Repository.Repository
+	User      *UserRepository.Repository
+	Token     *TokenRepository.Repository
+	Blog      *BlogRepository.Repository
+	AI        *AIRepository.Repository
+	Image     *ImageRepository.Repository
+	R2        *R2Repository.Repository
+	Audit     *AuditRepository.Repository
+	Analytics *AnalyticsRepository.Repository
 }
 
 type Services struct {
 	BlogCache   *cache.Cache
+	Sessions    *cache.SessionCacheService
+	LoginLimit  *cache.LoginAttemptCacheService
+	RateLimit   *cache.RateLimitCacheService
+	TwoFactor   *cache.TwoFactorChallengeCacheService
+	Mailer      mailer.Mailer
 	AIRateLimit *middlewares.AIRateLimitMiddleware
 	AI          *AIService.AIService
+	Scheduler   *SchedulerService.SchedulerService
+	Trash       *TrashService.TrashService
+	Tokens      *TokenService.TokenCleanupService
+	Audit       *AuditService.AuditService
+	Analytics   *AnalyticsService.AnalyticsService
 }
 
 type Handlers struct {
@@ -80,6 +105,22 @@ func main() {
 	// 4. Servis Katmanını Başlat
 	s := initServices(r)
 
+	// Zamanlanmış blogları yayınlayan arka plan servisi
+	s.Scheduler.Start()
+	defer s.Scheduler.Stop()
+
+	// Saklama süresi dolan çöp kutusu bloglarını kalıcı olarak silen arka plan servisi
+	s.Trash.Start()
+	defer s.Trash.Stop()
+
+	// Rotate edilmiş ve süresi dolmuş refresh tokenları temizleyen arka plan servisi
+	s.Tokens.Start()
+	defer s.Tokens.Stop()
+
+	// Arama kayıtlarını toplu olarak yazan arka plan servisi
+	s.Analytics.Start()
+	defer s.Analytics.Stop()
+
 	// 5. Handler Katmanını Başlat
 	h := initHandlers(r, s)
 
@@ -91,7 +132,7 @@ func main() {
 
 	// Kimlik doğrulama gerektiren rotalar için grup
 	auth := router.Group("/auth")
-	auth.Use(mw.AuthMiddleware(r.User, r.Token))
+	auth.Use(mw.AuthMiddleware(r.User, r.Token, s.Sessions))
 
 	// Global Routes
 	router.GET("/", h.Main.Index)
@@ -99,33 +140,96 @@ func main() {
 
 	// Authentication Routes (public)
 	router.POST("/login", h.User.Login)
+	router.POST("/login/2fa", h.User.LoginTwoFactor)
+	router.POST("/login/2fa/setup", h.User.LoginTwoFactorSetup)
 	router.POST("/register", h.User.Register)
-	auth.GET("/logout", h.User.Logout)
+	router.POST("/forgot-password", h.User.ForgotPassword)
+	router.POST("/reset-password", h.User.ResetPassword)
+	router.POST("/verify-email", h.User.VerifyEmail)
+	auth.GET("/logout", mw.SessionOnly(), h.User.Logout)
 	auth.GET("/get-me", h.User.GetMe)
+	auth.POST("/change-password", mw.SessionOnly(), h.User.ChangePassword)
+	auth.POST("/resend-verification", mw.SessionOnly(), h.User.ResendVerificationEmail)
+
+	// Two Factor Routes
+	twoFactor := auth.Group("/2fa")
+	twoFactor.Use(mw.SessionOnly())
+	{
+		twoFactor.GET("", h.User.GetTwoFactorStatus)
+		twoFactor.POST("/setup", h.User.SetupTwoFactor)
+		twoFactor.POST("/confirm", h.User.ConfirmTwoFactor)
+		twoFactor.POST("/disable", h.User.DisableTwoFactor)
+		twoFactor.POST("/recovery-codes", h.User.RegenerateRecoveryCodes)
+	}
+
+	// Session Routes
+	sessions := auth.Group("/sessions")
+	sessions.Use(mw.SessionOnly())
+	{
+		sessions.GET("", h.User.GetSessions)
+		sessions.DELETE("/:id", h.User.RevokeSession)
+		sessions.POST("/revoke-others", h.User.RevokeOtherSessions)
+	}
+
+	// API Key Routes
+	apiKeys := auth.Group("/api-keys")
+	apiKeys.Use(mw.SessionOnly())
+	{
+		apiKeys.GET("", h.User.GetAPIKeys)
+		apiKeys.POST("", h.User.CreateAPIKey)
+		apiKeys.DELETE("/:id", h.User.RevokeAPIKey)
+	}
 
 	// Blog Routes - Auth Required
 	blogAuth := auth.Group("/blog")
+	blogAuth.Use(mw.RequireAPIScope(c.ScopeBlogRead, c.ScopeBlogWrite))
 	{
 		// Oluşturma işlemleri
-		blogAuth.POST("", h.Blog.CreateBlogPost)
-		blogAuth.POST("/tag", h.Blog.CreateBlogTag)
-		blogAuth.POST("/category", h.Blog.CreateBlogCategory)
+		blogAuth.POST("", mw.RequirePermission(c.CreatePost), mw.RequireVerifiedEmail(), h.Blog.CreateBlogPost)
+		blogAuth.POST("/tag", mw.RequirePermission(c.ManageTaxonomy), h.Blog.CreateBlogTag)
+		blogAuth.POST("/category", mw.RequirePermission(c.ManageTaxonomy), h.Blog.CreateBlogCategory)
 
 		// Güncelleme işlemleri
-		blogAuth.PATCH("", h.Blog.UpdateBlogPost)
-		blogAuth.PATCH("/status", h.Blog.UpdateBlogStatus)
+		blogAuth.PATCH("", mw.RequirePermission(c.EditPost), h.Blog.UpdateBlogPost)
+		blogAuth.PATCH("/status", mw.RequirePermission(c.EditPost), h.Blog.UpdateBlogStatus)
+		blogAuth.PATCH("/status/schedule", mw.RequirePermission(c.EditPost), h.Blog.ScheduleBlogPost)
+		blogAuth.POST("/bulk", h.Blog.BulkUpdateBlogs)
+
+		// Çeviri grubu işlemleri
+		blogAuth.GET("/groups/:groupId", h.Blog.GetBlogGroup)
+		blogAuth.PUT("/:id/group", mw.RequirePermission(c.EditPost), h.Blog.AttachBlogToGroup)
+		blogAuth.DELETE("/:id/group", mw.RequirePermission(c.EditPost), h.Blog.DetachBlogFromGroup)
+		blogAuth.DELETE("/status/schedule/:id", mw.RequirePermission(c.EditPost), h.Blog.CancelScheduledBlogPost)
 
 		// Featured işlemleri
-		blogAuth.POST("/featured", h.Blog.AddToFeatured)
-		blogAuth.DELETE("/featured/:id", h.Blog.RemoveFromFeatured)
-		blogAuth.PATCH("/featured/ordering", h.Blog.UpdateFeaturedOrdering)
+		blogAuth.POST("/featured", mw.RequirePermission(c.ManageFeatured), h.Blog.AddToFeatured)
+		blogAuth.DELETE("/featured/:id", mw.RequirePermission(c.ManageFeatured), h.Blog.RemoveFromFeatured)
+		blogAuth.PATCH("/featured/ordering", mw.RequirePermission(c.ManageFeatured), h.Blog.UpdateFeaturedOrdering)
 
 		// İstatistik işlemleri
 		blogAuth.GET("/stats", h.Blog.GetBlogStats)
 		blogAuth.GET("/stats/:id", h.Blog.GetBlogStatByID)
 
+		// Editör görünümü ve taslak (autosave) işlemleri
+		blogAuth.GET("/:id", h.Blog.SelectEditorBlogByID)
+		blogAuth.GET("/:id/draft", h.Blog.SelectBlogDraft)
+		blogAuth.PUT("/:id/draft", mw.RequirePermission(c.EditPost), h.Blog.SaveBlogDraft)
+		blogAuth.DELETE("/:id/draft", mw.RequirePermission(c.EditPost), h.Blog.DeleteBlogDraft)
+		blogAuth.POST("/:id/draft/publish", mw.RequirePermission(c.EditPost), h.Blog.PublishBlogDraft)
+
+		// Revizyon işlemleri
+		blogAuth.GET("/:id/revisions", h.Blog.SelectBlogRevisions)
+		blogAuth.GET("/:id/revisions/compare", h.Blog.CompareBlogRevisions)
+		blogAuth.GET("/:id/revisions/:revisionId", h.Blog.SelectBlogRevisionByID)
+		blogAuth.POST("/:id/revisions/:revisionId/restore", mw.RequirePermission(c.EditPost), h.Blog.RestoreBlogRevision)
+
 		// Silme işlemleri
-		blogAuth.DELETE("/:id", h.Blog.DeleteBlogByID)
+		blogAuth.DELETE("/:id", mw.RequirePermission(c.DeletePost), h.Blog.DeleteBlogByID)
+
+		// Çöp kutusu işlemleri (kalıcı silme yalnızca admin)
+		blogAuth.GET("/trash", mw.RequirePermission(c.DeletePost), h.Blog.GetTrashedBlogs)
+		blogAuth.POST("/trash/:id/restore", mw.RequirePermission(c.DeletePost), h.Blog.RestoreTrashedBlog)
+		blogAuth.DELETE("/trash/:id", mw.SessionOnly(), mw.RequireRole("Admin"), h.Blog.PurgeTrashedBlog)
 	}
 
 	// Blog Routes - Public Access
@@ -133,6 +237,8 @@ func main() {
 	{
 		blogPublic.GET("", h.Blog.SelectBlogBySlugID)
 		blogPublic.GET("/cards", h.Blog.SelectBlogCards)
+		blogPublic.GET("/search", h.Blog.SearchBlogs)
+		blogPublic.POST("/search/click", mw.IPRateLimit(s.RateLimit, "search_click", c.SEARCH_CLICK_RATE_LIMIT, c.SEARCH_CLICK_RATE_WINDOW), h.Blog.TrackSearchClick)
 		blogPublic.GET("/:id", h.Blog.SelectBlogByID)
 		blogPublic.GET("/tags", h.Blog.SelectAllTags)
 		blogPublic.GET("/categories", h.Blog.SelectAllCategories)
@@ -146,24 +252,26 @@ func main() {
 
 	// Image Routes
 	imageAuth := auth.Group("/images")
+	imageAuth.Use(mw.RequireAPIScope(c.ScopeImageRead, c.ScopeImageWrite))
 	{
-		imageAuth.POST("/presign", h.Image.CreatePresignedURL)
-		imageAuth.POST("/confirm", h.Image.ConfirmUpload)
+		imageAuth.POST("/presign", mw.RequirePermission(c.UploadImage), h.Image.CreatePresignedURL)
+		imageAuth.POST("/confirm", mw.RequirePermission(c.UploadImage), h.Image.ConfirmUpload)
 		imageAuth.GET("", h.Image.GetUserImages)
-		imageAuth.DELETE("/:id", h.Image.DeleteImage)
+		imageAuth.DELETE("/:id", mw.RequirePermission(c.DeleteImage), h.Image.DeleteImage)
 	}
 
 	// AI Routes
 	aiRoutes := auth.Group("/ai")
-	aiRoutes.Use(s.AIRateLimit.RateLimit())
+	aiRoutes.Use(mw.RequireAPIScope(c.ScopeAIUse, c.ScopeAIUse), mw.RequireVerifiedEmail(), s.AIRateLimit.RateLimit())
 	{
 		aiRoutes.POST("/translate", h.AI.TranslateBlogPostJSON)
 		aiRoutes.POST("/generate-metadata", h.AI.GenerateBlogMetadata)
+		aiRoutes.POST("/create-translation", mw.RequireAPIScope(c.ScopeBlogWrite, c.ScopeBlogWrite), mw.RequirePermission(c.CreatePost), h.AI.CreateBlogTranslations)
 	}
 
 	// Admin Routes
 	adminAuth := auth.Group("/admin")
-	adminAuth.Use(mw.RequireRole("Admin"))
+	adminAuth.Use(mw.SessionOnly(), mw.RequireRole("Admin"))
 	adminCache := adminAuth.Group("/cache")
 	{
 		adminCache.GET("", h.Admin.GetCacheStats)
@@ -174,24 +282,78 @@ func main() {
 		adminCache.DELETE("/rate-limits", h.Admin.ClearAIRateLimits)
 		adminCache.DELETE("/rate-limits/:userId", h.Admin.ResetUserRateLimit)
 	}
+	adminLockouts := adminAuth.Group("/login-lockouts")
+	{
+		adminLockouts.GET("", h.Admin.GetLoginLockouts)
+		adminLockouts.DELETE("", h.Admin.ClearAllLoginLockouts)
+		adminLockouts.DELETE("/:scope/:identifier", h.Admin.ClearLoginLockout)
+	}
+	adminTwoFactor := adminAuth.Group("/2fa-policies")
+	{
+		adminTwoFactor.GET("", h.Admin.GetTwoFactorPolicies)
+		adminTwoFactor.PUT("/:role", h.Admin.UpdateTwoFactorPolicy)
+	}
+	adminAuth.GET("/audit-events", h.Admin.GetAuditEvents)
+	adminSearch := adminAuth.Group("/search-analytics")
+	{
+		adminSearch.GET("/top", h.Admin.GetTopSearchQueries)
+		adminSearch.GET("/zero-results", h.Admin.GetZeroResultSearchQueries)
+		adminSearch.GET("/trending", h.Admin.GetTrendingSearchQueries)
+	}
+	adminUsers := adminAuth.Group("/users")
+	{
+		adminUsers.GET("", h.Admin.GetUsers)
+		adminUsers.GET("/:id", h.Admin.GetUserByID)
+		adminUsers.PATCH("/:id/role", h.Admin.UpdateUserRole)
+		adminUsers.PATCH("/:id/status", h.Admin.UpdateUserStatus)
+		adminUsers.DELETE("/:id", h.Admin.DeleteUser)
+		adminUsers.POST("/:id/revoke-sessions", h.Admin.RevokeUserSessions)
+		adminUsers.GET("/:id/sessions", h.Admin.GetUserSessions)
+		adminUsers.DELETE("/:id/sessions/:sessionId", h.Admin.RevokeUserSession)
+		adminUsers.GET("/:id/token-reuse-events", h.Admin.GetUserTokenReuseEvents)
+		adminUsers.DELETE("/:id/2fa", h.Admin.ResetUserTwoFactor)
+	}
 
 	// 7. Sunucuyu Başlat
 	port := os.Getenv("PORT")
-	log.Printf("[SERVER]: %s portu üzerinde dinleniyor...", port)
+	server := &http.Server{
+		Addr:    ":" + port,
+		Handler: router,
+	}
+
+	// SIGINT/SIGTERM geldiğinde sunucu kapatılır; ardından ertelenen Stop çağrıları bekleyen işleri tamamlar
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		log.Printf("[SERVER]: %s portu üzerinde dinleniyor...", port)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("[SERVER]: Sunucu başlatılırken hata: %v", err)
+			stop()
+		}
+	}()
+
+	<-ctx.Done()
+	log.Printf("[SERVER]: Sunucu kapatılıyor...")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), c.SERVER_SHUTDOWN_TIMEOUT)
+	defer cancel()
 
-	if err := router.Run(":" + port); err != nil {
-		log.Fatalf("[SERVER]: Sunucu başlatılırken hata: %v", err)
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Printf("[SERVER]: Sunucu düzgün kapatılamadı: %v", err)
 	}
 }
 
 // Repository'lerin başlatılması
 func initRepositories(sqlDB *sql.DB) Repositories {
 	return Repositories{
-		User:  UserRepository.NewRepository(sqlDB),
-		Token: TokenRepository.NewRepository(sqlDB),
-		Blog:  BlogRepository.NewRepository(sqlDB),
-		AI:    AIRepository.NewRepository(os.Getenv("OPENAI_API_KEY")),
-		Image: ImageRepository.NewRepository(sqlDB),
+		User:      UserRepository.NewRepository(sqlDB),
+		Token:     TokenRepository.NewRepository(sqlDB),
+		Blog:      BlogRepository.NewRepository(sqlDB),
+		AI:        AIRepository.NewRepository(os.Getenv("OPENAI_API_KEY")),
+		Image:     ImageRepository.NewRepository(sqlDB),
+		Audit:     AuditRepository.NewRepository(sqlDB),
+		Analytics: AnalyticsRepository.NewRepository(sqlDB),
 		R2: R2Repository.NewRepository(
 			os.Getenv("R2_ACCOUNT_ID"),
 			os.Getenv("R2_ACCESS_KEY_ID"),
@@ -208,11 +370,22 @@ func initRepositories(sqlDB *sql.DB) Repositories {
 func initServices(repos Repositories) Services {
 	// Cache ve servis oluştur
 	blogCache := cache.NewCache(30 * time.Minute)
+	audit := AuditService.NewAuditService(repos.Audit)
 
 	return Services{
 		BlogCache:   blogCache,
+		Sessions:    cache.NewSessionCacheService(blogCache),
+		LoginLimit:  cache.NewLoginAttemptCacheService(blogCache),
+		RateLimit:   cache.NewRateLimitCacheService(blogCache),
+		TwoFactor:   cache.NewTwoFactorChallengeCacheService(blogCache),
+		Mailer:      mailer.NewMailer(),
 		AIRateLimit: middlewares.NewAIRateLimitMiddleware(blogCache),
 		AI:          AIService.NewAIService(repos.AI, repos.Blog),
+		Scheduler:   SchedulerService.NewSchedulerService(repos.Blog, blogCache, audit),
+		Trash:       TrashService.NewTrashService(repos.Blog, repos.Image, repos.R2, blogCache, audit),
+		Tokens:      TokenService.NewTokenCleanupService(repos.Token),
+		Audit:       audit,
+		Analytics:   AnalyticsService.NewAnalyticsService(repos.Analytics),
 	}
 }
 
@@ -220,10 +393,10 @@ func initServices(repos Repositories) Services {
 func initHandlers(repos Repositories, services Services) Handlers {
 	return Handlers{
 		Main:  handlers.NewHandler(),
-		User:  UserHandler.NewHandler(repos.User, repos.Token),
-		Blog:  BlogHandler.NewHandler(repos.Blog, services.BlogCache),
-		Image: ImageHandler.NewHandler(repos.Image, repos.R2),
-		AI:    AIHandler.NewHandler(repos.AI, repos.Blog, services.AI),
-		Admin: AdminHandler.NewHandler(repos.Blog, services.BlogCache),
+		User:  UserHandler.NewHandler(repos.User, repos.Token, services.Mailer, services.Sessions, services.LoginLimit, services.TwoFactor, services.Audit),
+		Blog:  BlogHandler.NewHandler(repos.Blog, services.BlogCache, services.Audit, services.Trash, services.Analytics),
+		Image: ImageHandler.NewHandler(repos.Image, repos.R2, services.Audit),
+		AI:    AIHandler.NewHandler(repos.AI, repos.Blog, services.AI, services.BlogCache, services.Audit),
+		Admin: AdminHandler.NewHandler(repos.Blog, repos.User, repos.Token, services.BlogCache, services.Audit, services.Analytics),
 	}
 }
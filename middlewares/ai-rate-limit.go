@@ -112,9 +112,13 @@ func (m *AIRateLimitMiddleware) RateLimit() gin.HandlerFunc {
 		// İsteği işle
 		c.Next()
 
-		// Başarılı istek sonrası token kullanımını güncelle
+		// Başarılı istek sonrası token kullanımını güncelle.
+		// Handler gerçek kullanımı "tokens_used" ile bildirmişse o değer, aksi halde sabit tahmin kullanılır.
 		if c.Writer.Status() == http.StatusOK {
 			tokensUsed := 1000
+			if reported := c.GetInt("tokens_used"); reported > 0 {
+				tokensUsed = reported
+			}
 			m.updateTokenUsage(userID.String(), tokensUsed)
 		}
 	}
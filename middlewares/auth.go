@@ -2,7 +2,9 @@ package middlewares
 
 import (
 	"errors"
+	"log"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -11,12 +13,26 @@ import (
 	"github.com/okanay/backend-blog-guideofdubai/configs"
 	TokenRepository "github.com/okanay/backend-blog-guideofdubai/repositories/token"
 	UserRepository "github.com/okanay/backend-blog-guideofdubai/repositories/user"
+	"github.com/okanay/backend-blog-guideofdubai/services/cache"
 	"github.com/okanay/backend-blog-guideofdubai/types"
 	"github.com/okanay/backend-blog-guideofdubai/utils"
 )
 
-func AuthMiddleware(ur *UserRepository.Repository, tr *TokenRepository.Repository) gin.HandlerFunc {
+// Context'teki "auth_method" değerleri
+const (
+	AuthMethodSession = "session"
+	AuthMethodAPIKey  = "api_key"
+)
+
+func AuthMiddleware(ur *UserRepository.Repository, tr *TokenRepository.Repository, sessions *cache.SessionCacheService) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		// 0. Machine clients authenticate with an API key instead of cookies.
+		// Other Authorization headers (e.g. from a proxy) fall back to cookie authentication.
+		if apiKey, ok := utils.BearerToken(c.GetHeader("Authorization")); ok && strings.HasPrefix(apiKey, configs.API_KEY_PREFIX) {
+			handleAPIKeyAuth(c, tr, apiKey)
+			return
+		}
+
 		// 1. Check access token
 		accessToken, err := c.Cookie(configs.ACCESS_TOKEN_NAME)
 		if err != nil {
@@ -37,7 +53,14 @@ func AuthMiddleware(ur *UserRepository.Repository, tr *TokenRepository.Repositor
 			return
 		}
 
+		// 3. Oturumları geçersiz kılınmadan önce üretilmiş token'lar veritabanından yeniden doğrulanır
+		if sessions.IsUserSessionInvalidated(claims.ID, claims.TokenIssuedAt) {
+			handleTokenRenewal(c, ur, tr)
+			return
+		}
+
 		setContextValues(c, claims.ID, claims.Username, claims.Email, claims.Role, claims.EmailVerified, claims.Status, claims.CreatedAt, claims.LastLogin)
+		c.Set("auth_method", AuthMethodSession)
 
 		// 4. Continue processing
 		c.Next()
@@ -54,21 +77,26 @@ func handleTokenRenewal(c *gin.Context, ur *UserRepository.Repository, tr *Token
 		return
 	}
 
-	// 2. Check the refresh token in the database
-	dbToken, err := tr.SelectRefreshTokenByToken(refreshToken)
+	// 2. Rotate the refresh token (single use, reuse detection inside the token family)
+	dbToken, err := tr.RotateRefreshToken(
+		refreshToken,
+		utils.GenerateRefreshToken(),
+		utils.GetTrueClientIP(c),
+		c.Request.UserAgent(),
+		configs.REFRESH_TOKEN_REUSE_GRACE,
+	)
 	if err != nil {
-		handleUnauthorized(c, "Invalid session.")
-		return
-	}
-
-	// 3. Validate the refresh token
-	if dbToken.IsRevoked {
-		handleUnauthorized(c, "Session has been revoked.")
-		return
-	}
-
-	if dbToken.ExpiresAt.Before(time.Now()) {
-		handleUnauthorized(c, "Session has expired.")
+		// 3. Map rotation errors to session errors
+		switch {
+		case errors.Is(err, TokenRepository.ErrRefreshTokenReused):
+			handleUnauthorized(c, "Session token reuse detected. All sessions of this login have been revoked.")
+		case errors.Is(err, TokenRepository.ErrRefreshTokenRevoked):
+			handleUnauthorized(c, "Session has been revoked.")
+		case errors.Is(err, TokenRepository.ErrRefreshTokenExpired):
+			handleUnauthorized(c, "Session has expired.")
+		default:
+			handleUnauthorized(c, "Invalid session.")
+		}
 		return
 	}
 
@@ -87,10 +115,14 @@ func handleTokenRenewal(c *gin.Context, ur *UserRepository.Repository, tr *Token
 
 	// 6. Create token claims
 	tokenClaims := types.TokenClaims{
-		ID:       user.ID,
-		Username: user.Username,
-		Email:    user.Email,
-		Role:     user.Role,
+		ID:            user.ID,
+		Username:      user.Username,
+		Email:         user.Email,
+		Role:          user.Role,
+		EmailVerified: user.EmailVerified,
+		Status:        user.Status,
+		CreatedAt:     user.CreatedAt,
+		LastLogin:     user.LastLogin,
 	}
 
 	// 7. Generate a new access token
@@ -105,11 +137,17 @@ func handleTokenRenewal(c *gin.Context, ur *UserRepository.Repository, tr *Token
 		return
 	}
 
-	// 8. Update the last used time of the refresh token
-	err = tr.UpdateRefreshTokenLastUsed(refreshToken)
-	if err != nil {
-		// Logging can be done but it won't block the process
-	}
+	// 8. Set the rotated refresh token cookie (absolute session lifetime is preserved)
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(
+		configs.REFRESH_TOKEN_NAME,
+		dbToken.Token,
+		int(time.Until(dbToken.ExpiresAt).Seconds()),
+		"/",
+		"",
+		false,
+		true,
+	)
 
 	// 9. Set the new access token cookie
 	c.SetSameSite(http.SameSiteLaxMode)
@@ -125,10 +163,47 @@ func handleTokenRenewal(c *gin.Context, ur *UserRepository.Repository, tr *Token
 
 	// 10. Add user information to the context
 	setContextValues(c, user.ID, user.Username, user.Email, user.Role, user.EmailVerified, user.Status, user.CreatedAt, user.LastLogin)
+	c.Set("refresh_token", dbToken.Token)
+	c.Set("auth_method", AuthMethodSession)
 	// 11. Continue processing
 	c.Next()
 }
 
+func handleAPIKeyAuth(c *gin.Context, tr *TokenRepository.Repository, apiKey string) {
+	defer utils.TimeTrack(time.Now(), "Token -> API Key Authentication")
+
+	// 1. Look up the key together with the owner's current role and status
+	auth, err := tr.SelectActiveAPIKeyByHash(utils.HashToken(apiKey))
+	if err != nil {
+		utils.Unauthorized(c, "Geçersiz veya iptal edilmiş API anahtarı.")
+		c.Abort()
+		return
+	}
+
+	if auth.User.Status != types.UserStatusActive {
+		utils.Unauthorized(c, "Hesabınız aktif değil.")
+		c.Abort()
+		return
+	}
+
+	// 2. Track last usage in the background (does not block the request)
+	keyID, clientIP := auth.Key.ID, utils.GetTrueClientIP(c)
+	go func() {
+		if err := tr.TouchAPIKey(keyID, clientIP); err != nil {
+			log.Printf("[API KEY]: Son kullanım bilgisi güncellenemedi: %v", err)
+		}
+	}()
+
+	// 3. Add user and key information to the context
+	user := auth.User
+	setContextValues(c, user.ID, user.Username, user.Email, user.Role, user.EmailVerified, user.Status, user.CreatedAt, user.LastLogin)
+	c.Set("auth_method", AuthMethodAPIKey)
+	c.Set("api_key_id", auth.Key.ID)
+	c.Set("api_key_scopes", auth.Key.Scopes)
+
+	c.Next()
+}
+
 func handleUnauthorized(c *gin.Context, message string) {
 	// Clear cookies
 	c.SetSameSite(http.SameSiteLaxMode)
@@ -0,0 +1,33 @@
+package middlewares
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-blog-guideofdubai/services/cache"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// IPRateLimit herkese açık uç noktaları istemci IP'si başına pencere içinde en fazla limit istekle sınırlar
+func IPRateLimit(limiter *cache.RateLimitCacheService, scope string, limit int, window time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		retryAfter, allowed := limiter.Allow(scope, utils.GetTrueClientIP(c), limit, window)
+		if !allowed {
+			seconds := int(retryAfter.Seconds()) + 1
+
+			c.Header("Retry-After", strconv.Itoa(seconds))
+			c.JSON(http.StatusTooManyRequests, gin.H{
+				"success":    false,
+				"error":      "rate_limit_exceeded",
+				"message":    "Çok fazla istek gönderildi. Lütfen " + strconv.Itoa(seconds) + " saniye sonra tekrar deneyin.",
+				"retryAfter": seconds,
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,56 @@
+// middlewares/require_api_scope.go
+package middlewares
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+)
+
+// RequireAPIScope API anahtarı ile gelen isteklerde anahtarın ilgili kapsama sahip olmasını gerektirir.
+// Okuma isteklerinde (GET/HEAD) readScope, diğer isteklerde writeScope aranır.
+// Çerez tabanlı oturumlar bu kontrolden etkilenmez.
+func RequireAPIScope(readScope configs.APIScope, writeScope configs.APIScope) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString("auth_method") != AuthMethodAPIKey {
+			c.Next()
+			return
+		}
+
+		required := writeScope
+		if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+			required = readScope
+		}
+
+		if !slices.Contains(c.GetStringSlice("api_key_scopes"), string(required)) {
+			c.JSON(http.StatusForbidden, gin.H{
+				"success": false,
+				"error":   "insufficient_scope",
+				"message": "API anahtarının bu işlem için gerekli yetki kapsamı yok: " + string(required),
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// SessionOnly yalnızca çerez tabanlı oturumlarla yapılabilecek işlemler için API anahtarlarını reddeder
+func SessionOnly() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString("auth_method") == AuthMethodAPIKey {
+			c.JSON(http.StatusForbidden, gin.H{
+				"success": false,
+				"error":   "session_required",
+				"message": "Bu işlem API anahtarı ile yapılamaz.",
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
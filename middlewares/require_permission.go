@@ -0,0 +1,30 @@
+// middlewares/require_permission.go
+package middlewares
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// RequirePermission rolün verilen izne sahip olmasını gerektiren middleware.
+// "own" erişiminde kaynak sahipliği handler içinde ayrıca kontrol edilir.
+func RequirePermission(permission configs.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role, exists := c.Get("role")
+		if !exists {
+			utils.Unauthorized(c, "Yetkilendirme bilgisi bulunamadı")
+			c.Abort()
+			return
+		}
+
+		if configs.GetAccess(role.(types.Role), permission) == configs.AccessNone {
+			utils.Forbidden(c, "")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
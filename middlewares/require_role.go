@@ -2,7 +2,6 @@
 package middlewares
 
 import (
-	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -24,8 +23,8 @@ func RequireRole(requiredRole types.Role) gin.HandlerFunc {
 			return
 		}
 
-		fmt.Println("Role:", role)
-		if role != requiredRole || role != types.RoleAdmin {
+		// Admin tüm rol gerektiren rotalara erişebilir
+		if role != requiredRole && role != types.RoleAdmin {
 			c.JSON(http.StatusForbidden, gin.H{
 				"success": false,
 				"error":   "forbidden",
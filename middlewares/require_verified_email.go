@@ -0,0 +1,33 @@
+// middlewares/require_verified_email.go
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// RequireVerifiedEmail e-posta adresi doğrulanmış olmayı gerektiren middleware
+func RequireVerifiedEmail() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		verified, exists := c.Get("email_verified")
+		if !exists {
+			utils.Unauthorized(c, "Yetkilendirme bilgisi bulunamadı")
+			c.Abort()
+			return
+		}
+
+		if isVerified, ok := verified.(bool); !ok || !isVerified {
+			c.JSON(http.StatusForbidden, gin.H{
+				"success": false,
+				"error":   "email_not_verified",
+				"message": "Bu işlem için e-posta adresinizi doğrulamanız gerekiyor.",
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
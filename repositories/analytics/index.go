@@ -0,0 +1,13 @@
+package AnalyticsRepository
+
+import (
+	"database/sql"
+)
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
@@ -0,0 +1,59 @@
+package AnalyticsRepository
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// SaveSearchBatch aramaları tek INSERT ile ekler, ardından tıklamaları işler.
+// Aynı batch'teki tıklamaların aramaları önce yazıldığı için sıralama korunur; yalnızca ilk tıklama kaydedilir.
+func (r *Repository) SaveSearchBatch(events []types.SearchQueryEvent, clicks []types.SearchClickEvent) error {
+	defer utils.TimeTrack(time.Now(), "Analytics -> Save Search Batch")
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if len(events) > 0 {
+		values := make([]string, 0, len(events))
+		params := make([]any, 0, len(events)*6)
+
+		for i, event := range events {
+			n := i * 6
+			values = append(values, fmt.Sprintf("($%d, $%d, NULLIF($%d, ''), $%d, $%d, $%d)", n+1, n+2, n+3, n+4, n+5, n+6))
+			params = append(params, event.ID, event.Query, event.Language, event.Source, event.ResultCount, event.CreatedAt)
+		}
+
+		query := `
+			INSERT INTO search_queries (id, query, language, source, result_count, created_at)
+			VALUES ` + strings.Join(values, ", ") + `
+			ON CONFLICT (id) DO NOTHING
+		`
+		if _, err := tx.Exec(query, params...); err != nil {
+			return fmt.Errorf("error inserting search queries: %w", err)
+		}
+	}
+
+	for _, click := range clicks {
+		_, err := tx.Exec(`
+			UPDATE search_queries
+			SET clicked_blog_id = $2, clicked_at = $3
+			WHERE id = $1 AND clicked_at IS NULL
+		`, click.SearchID, click.BlogID, click.ClickedAt)
+		if err != nil {
+			return fmt.Errorf("error updating search click: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
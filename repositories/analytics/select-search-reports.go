@@ -0,0 +1,146 @@
+package AnalyticsRepository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// SelectTopSearchQueries zaman aralığında en çok aranan sorguları listeler
+func (r *Repository) SelectTopSearchQueries(options types.SearchAnalyticsOptions) ([]types.SearchQueryStat, error) {
+	defer utils.TimeTrack(time.Now(), "Analytics -> Select Top Search Queries")
+
+	return r.selectSearchQueryStats(options, "", "searches DESC")
+}
+
+// SelectZeroResultSearchQueries zaman aralığında sonuç bulunamayan sorguları en sık aranandan başlayarak listeler.
+// Editörlerin hangi konularda içerik eksik olduğunu görmesi içindir.
+func (r *Repository) SelectZeroResultSearchQueries(options types.SearchAnalyticsOptions) ([]types.SearchQueryStat, error) {
+	defer utils.TimeTrack(time.Now(), "Analytics -> Select Zero Result Search Queries")
+
+	return r.selectSearchQueryStats(options, "HAVING COUNT(*) FILTER (WHERE result_count = 0) > 0", "zero_results DESC")
+}
+
+func (r *Repository) selectSearchQueryStats(options types.SearchAnalyticsOptions, having string, orderBy string) ([]types.SearchQueryStat, error) {
+	params := []any{options.From, options.To}
+	languageFilter := ""
+	if options.Language != "" {
+		params = append(params, options.Language)
+		languageFilter = fmt.Sprintf(" AND language = $%d", len(params))
+	}
+	params = append(params, options.Limit)
+
+	query := fmt.Sprintf(`
+		SELECT
+			query,
+			COUNT(*) AS searches,
+			COUNT(*) FILTER (WHERE result_count = 0) AS zero_results,
+			COUNT(clicked_at) AS clicks,
+			AVG(result_count)::float8,
+			MAX(created_at)
+		FROM search_queries
+		WHERE created_at >= $1 AND created_at < $2%s
+		GROUP BY query
+		%s
+		ORDER BY %s, query
+		LIMIT $%d
+	`, languageFilter, having, orderBy, len(params))
+
+	rows, err := r.db.Query(query, params...)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving search query stats: %w", err)
+	}
+	defer rows.Close()
+
+	stats := []types.SearchQueryStat{}
+	for rows.Next() {
+		var stat types.SearchQueryStat
+		err := rows.Scan(
+			&stat.Query,
+			&stat.Searches,
+			&stat.ZeroResults,
+			&stat.Clicks,
+			&stat.AvgResults,
+			&stat.LastSearchedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning search query stat: %w", err)
+		}
+
+		if stat.Searches > 0 {
+			stat.ClickThroughRate = float64(stat.Clicks) / float64(stat.Searches)
+		}
+		stats = append(stats, stat)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating search query stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+// SelectTrendingSearchQueries aralıktaki arama sayısını bir önceki eşit uzunluktaki aralıkla karşılaştırır
+// ve en hızlı artan sorguları döndürür. Az aranan sorgular gürültü olmaması için elenir.
+func (r *Repository) SelectTrendingSearchQueries(options types.SearchAnalyticsOptions) ([]types.TrendingSearchQuery, error) {
+	defer utils.TimeTrack(time.Now(), "Analytics -> Select Trending Search Queries")
+
+	previousFrom := options.From.Add(-options.To.Sub(options.From))
+	params := []any{previousFrom, options.From, options.To, configs.SEARCH_ANALYTICS_TRENDING_MIN}
+
+	languageFilter := ""
+	if options.Language != "" {
+		params = append(params, options.Language)
+		languageFilter = fmt.Sprintf(" AND language = $%d", len(params))
+	}
+	params = append(params, options.Limit)
+
+	query := fmt.Sprintf(`
+		WITH windowed AS (
+			SELECT query, created_at >= $2 AS is_current
+			FROM search_queries
+			WHERE created_at >= $1 AND created_at < $3%s
+		),
+		counts AS (
+			SELECT
+				query,
+				COUNT(*) FILTER (WHERE is_current) AS searches,
+				COUNT(*) FILTER (WHERE NOT is_current) AS previous_searches
+			FROM windowed
+			GROUP BY query
+		)
+		SELECT
+			query,
+			searches,
+			previous_searches,
+			searches::float8 / GREATEST(previous_searches, 1) AS growth
+		FROM counts
+		WHERE searches >= $4 AND searches > previous_searches
+		ORDER BY growth DESC, searches DESC, query
+		LIMIT $%d
+	`, languageFilter, len(params))
+
+	rows, err := r.db.Query(query, params...)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving trending search queries: %w", err)
+	}
+	defer rows.Close()
+
+	trending := []types.TrendingSearchQuery{}
+	for rows.Next() {
+		var item types.TrendingSearchQuery
+		if err := rows.Scan(&item.Query, &item.Searches, &item.PreviousSearches, &item.Growth); err != nil {
+			return nil, fmt.Errorf("error scanning trending search query: %w", err)
+		}
+		trending = append(trending, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating trending search queries: %w", err)
+	}
+
+	return trending, nil
+}
@@ -0,0 +1,46 @@
+package AuditRepository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// CreateAuditEvent yeni bir audit kaydı ekler
+func (r *Repository) CreateAuditEvent(input types.AuditEventInput) error {
+	defer utils.TimeTrack(time.Now(), "Audit -> Create Audit Event")
+
+	query := `
+		INSERT INTO audit_events (
+			actor_id, actor_username, action, target_type, target_id,
+			before, after, ip_address, user_agent, auth_method
+		) VALUES ($1, NULLIF($2, ''), $3, $4, NULLIF($5, ''), $6, $7, NULLIF($8, ''), NULLIF($9, ''), NULLIF($10, ''))
+	`
+
+	_, err := r.db.Exec(query,
+		input.ActorID,
+		input.ActorUsername,
+		input.Action,
+		input.TargetType,
+		input.TargetID,
+		nullableJSON(input.Before),
+		nullableJSON(input.After),
+		input.IPAddress,
+		input.UserAgent,
+		input.AuthMethod,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating audit event: %w", err)
+	}
+
+	return nil
+}
+
+func nullableJSON(data []byte) any {
+	if len(data) == 0 {
+		return nil
+	}
+	return string(data)
+}
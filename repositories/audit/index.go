@@ -0,0 +1,13 @@
+package AuditRepository
+
+import (
+	"database/sql"
+)
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
@@ -0,0 +1,132 @@
+package AuditRepository
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// SelectAuditEvents audit kayıtlarını filtreleyerek en yeniden eskiye listeler ve toplam sayıyı döndürür
+func (r *Repository) SelectAuditEvents(options types.AuditQueryOptions) ([]types.AuditEvent, int, error) {
+	defer utils.TimeTrack(time.Now(), "Audit -> Select Audit Events")
+
+	conditions := []string{}
+	params := []any{}
+	paramCounter := 1
+
+	if options.ActorID != nil {
+		conditions = append(conditions, fmt.Sprintf("actor_id = $%d", paramCounter))
+		params = append(params, *options.ActorID)
+		paramCounter++
+	}
+
+	if options.Action != "" {
+		// "blog." gibi nokta ile biten değerler bir işlem grubunu filtreler
+		if strings.HasSuffix(options.Action, ".") {
+			conditions = append(conditions, fmt.Sprintf("action LIKE $%d", paramCounter))
+			params = append(params, options.Action+"%")
+		} else {
+			conditions = append(conditions, fmt.Sprintf("action = $%d", paramCounter))
+			params = append(params, options.Action)
+		}
+		paramCounter++
+	}
+
+	if options.TargetType != "" {
+		conditions = append(conditions, fmt.Sprintf("target_type = $%d", paramCounter))
+		params = append(params, options.TargetType)
+		paramCounter++
+	}
+
+	if options.TargetID != "" {
+		conditions = append(conditions, fmt.Sprintf("target_id = $%d", paramCounter))
+		params = append(params, options.TargetID)
+		paramCounter++
+	}
+
+	if options.From != nil {
+		conditions = append(conditions, fmt.Sprintf("created_at >= $%d", paramCounter))
+		params = append(params, *options.From)
+		paramCounter++
+	}
+
+	if options.To != nil {
+		conditions = append(conditions, fmt.Sprintf("created_at <= $%d", paramCounter))
+		params = append(params, *options.To)
+		paramCounter++
+	}
+
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int
+	countQuery := "SELECT COUNT(*) FROM audit_events" + whereClause
+	if err := r.db.QueryRow(countQuery, params...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("error counting audit events: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			id, actor_id, COALESCE(actor_username, ''), action, target_type, COALESCE(target_id, ''),
+			before, after, COALESCE(ip_address, ''), COALESCE(user_agent, ''), COALESCE(auth_method, ''), created_at
+		FROM audit_events%s
+		ORDER BY created_at DESC
+		LIMIT $%d OFFSET $%d
+	`, whereClause, paramCounter, paramCounter+1)
+	params = append(params, options.Limit, options.Offset)
+
+	rows, err := r.db.Query(query, params...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error retrieving audit events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []types.AuditEvent{}
+	for rows.Next() {
+		var event types.AuditEvent
+		var actorID uuid.NullUUID
+		var before, after []byte
+
+		err := rows.Scan(
+			&event.ID,
+			&actorID,
+			&event.ActorUsername,
+			&event.Action,
+			&event.TargetType,
+			&event.TargetID,
+			&before,
+			&after,
+			&event.IPAddress,
+			&event.UserAgent,
+			&event.AuthMethod,
+			&event.CreatedAt,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error scanning audit event: %w", err)
+		}
+
+		if actorID.Valid {
+			event.ActorID = &actorID.UUID
+		}
+		if len(before) > 0 {
+			event.Before = before
+		}
+		if len(after) > 0 {
+			event.After = after
+		}
+
+		events = append(events, event)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error processing audit event rows: %w", err)
+	}
+
+	return events, total, nil
+}
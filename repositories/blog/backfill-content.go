@@ -0,0 +1,93 @@
+package BlogRepository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// SelectBlogContentBatch blog_content satırlarını id sırasıyla afterID'den sonra gelenlerden başlayarak döndürür.
+// İlk parça için uuid.Nil verilir.
+func (r *Repository) SelectBlogContentBatch(afterID uuid.UUID, limit int) ([]types.BlogContentBackfillRow, error) {
+	defer utils.TimeTrack(time.Now(), "Blog -> Select Blog Content Batch")
+
+	query := `
+		SELECT bc.id, bp.language, bc.html, bc.json
+		FROM blog_content bc
+		JOIN blog_posts bp ON bp.id = bc.id
+		WHERE bc.id > $1
+		ORDER BY bc.id
+		LIMIT $2
+	`
+
+	rows, err := r.db.Query(query, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error selecting blog content batch: %w", err)
+	}
+	defer rows.Close()
+
+	batch := []types.BlogContentBackfillRow{}
+	for rows.Next() {
+		var row types.BlogContentBackfillRow
+		if err := rows.Scan(&row.ID, &row.Language, &row.HTML, &row.JSON); err != nil {
+			return nil, fmt.Errorf("error scanning blog content row: %w", err)
+		}
+		batch = append(batch, row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating blog content rows: %w", err)
+	}
+
+	return batch, nil
+}
+
+// UpdateBlogContentHTML tek bir içeriğin HTML'ini günceller
+func (r *Repository) UpdateBlogContentHTML(blogID uuid.UUID, html string) error {
+	defer utils.TimeTrack(time.Now(), "Blog -> Update Blog Content HTML")
+
+	_, err := r.db.Exec(`UPDATE blog_content SET html = $1 WHERE id = $2`, html, blogID)
+	if err != nil {
+		return fmt.Errorf("error updating blog content html: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateBlogContentStats içeriğin kelime sayısını, içindekiler tablosunu ve okuma süresini JSON'dan yeniden hesaplar.
+// İçindekiler tablosundaki anchor'lar başlık id'lerine bağlı olduğu için HTML de aynı güncellemede JSON'dan yeniden üretilir.
+// JSON render edilemezse eski HTML korunur ve içindekiler tablosu boş bırakılır. Elle girilmiş okuma süresi (read_time_override) korunur.
+func (r *Repository) UpdateBlogContentStats(blogID uuid.UUID, language string, jsonContent string) error {
+	defer utils.TimeTrack(time.Now(), "Blog -> Update Blog Content Stats")
+
+	stats, readTime, err := resolveContentStats(language, types.ContentInput{JSON: jsonContent})
+	if err != nil {
+		return err
+	}
+
+	// NULL html mevcut değeri korur
+	var html *string
+	if rendered, err := utils.RenderTipTapHTML(jsonContent); err == nil {
+		sanitized := utils.SanitizeHTML(rendered)
+		html = &sanitized
+	} else {
+		stats.TOC = "[]"
+	}
+
+	query := `
+		UPDATE blog_content
+		SET word_count = $1, toc = $2, html = COALESCE($3, html),
+			read_time = CASE WHEN read_time_override THEN read_time ELSE $4 END
+		WHERE id = $5
+	`
+
+	_, err = r.db.Exec(query, stats.WordCount, stats.TOC, html, readTime, blogID)
+	if err != nil {
+		return fmt.Errorf("error updating blog content stats: %w", err)
+	}
+
+	return nil
+}
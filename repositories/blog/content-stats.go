@@ -0,0 +1,44 @@
+package BlogRepository
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// contentStatsRow blog_content tablosuna yazılan hesaplanmış içerik alanları
+type contentStatsRow struct {
+	WordCount int
+	TOC       string // JSONB kolonu için serileştirilmiş içindekiler tablosu
+}
+
+// resolveContentStats içerik JSON'ından kelime sayısı ve içindekiler tablosunu hesaplar.
+// Okuma süresi hesaplanan değerdir; yalnızca readTimeOverride açıkken ve geçerli bir değer gönderildiyse elle girilen kullanılır.
+func resolveContentStats(language string, content types.ContentInput) (*contentStatsRow, int, error) {
+	stats := utils.AnalyzeTipTapContent(content.JSON, language)
+
+	toc, err := json.Marshal(stats.TOC)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error marshaling table of contents: %w", err)
+	}
+
+	readTime := stats.ReadTime
+	if content.ReadTimeOverride && content.ReadTime > 0 {
+		readTime = content.ReadTime
+	}
+
+	return &contentStatsRow{WordCount: stats.WordCount, TOC: string(toc)}, readTime, nil
+}
+
+// decodeTOC JSONB kolonundan okunan içindekiler tablosunu çözer; hatalı veri boş liste olarak döner
+func decodeTOC(data []byte) []types.TOCItem {
+	toc := []types.TOCItem{}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &toc); err != nil {
+			return []types.TOCItem{}
+		}
+	}
+	return toc
+}
@@ -37,7 +37,7 @@ func (r *Repository) CreateBlogPost(input types.BlogPostCreateInput, userID uuid
 	}
 
 	// 3. Create blog content
-	err = r.CreateBlogContent(tx, blogID, input.Content)
+	err = r.CreateBlogContent(tx, blogID, input.Language, input.Content)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create blog content: %w", err)
 	}
@@ -64,6 +64,12 @@ func (r *Repository) CreateBlogPost(input types.BlogPostCreateInput, userID uuid
 		}
 	}
 
+	// 7. Save the first revision
+	err = r.CreateBlogRevision(tx, blogID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create blog revision: %w", err)
+	}
+
 	if err = tx.Commit(); err != nil {
 		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
@@ -84,9 +90,9 @@ func (r *Repository) CreateBlogSkeleton(tx *sql.Tx, input types.BlogPostCreateIn
 	// featured alanı kaldırıldı
 	query := `
 		INSERT INTO blog_posts (
-			user_id, group_id, slug, language, status, created_at, updated_at
+			user_id, group_id, slug, language, status, scheduled_at, created_at, updated_at
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7
+			$1, $2, $3, $4, $5, $6, $7, $8
 		) RETURNING id
 	`
 
@@ -98,6 +104,7 @@ func (r *Repository) CreateBlogSkeleton(tx *sql.Tx, input types.BlogPostCreateIn
 		input.Slug,
 		input.Language,
 		input.Status,
+		scheduledAtForStatus(input.Status, input.ScheduledAt),
 		now,
 		now,
 	).Scan(&blogID)
@@ -135,25 +142,33 @@ func (r *Repository) CreateBlogMetadata(tx *sql.Tx, blogID uuid.UUID, metadata t
 	return nil
 }
 
-func (r *Repository) CreateBlogContent(tx *sql.Tx, blogID uuid.UUID, content types.ContentInput) error {
+func (r *Repository) CreateBlogContent(tx *sql.Tx, blogID uuid.UUID, language string, content types.ContentInput) error {
 	defer utils.TimeTrack(time.Now(), "Blog -> Create Blog Content")
 
+	stats, readTime, err := resolveContentStats(language, content)
+	if err != nil {
+		return err
+	}
+
 	query := `
 		INSERT INTO blog_content (
-			id, title, description, image, read_time, html, json
+			id, title, description, image, read_time, read_time_override, word_count, toc, html, json
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 		)
 	`
 
-	_, err := tx.Exec(
+	_, err = tx.Exec(
 		query,
 		blogID,
 		content.Title,
 		content.Description,
 		content.Image,
-		content.ReadTime,
-		content.HTML,
+		readTime,
+		content.ReadTimeOverride,
+		stats.WordCount,
+		stats.TOC,
+		utils.SanitizeHTML(content.HTML), // Saklanan HTML her zaman izin listesinden geçer
 		content.JSON,
 	)
 
@@ -9,37 +9,29 @@ import (
 	"github.com/okanay/backend-blog-guideofdubai/utils"
 )
 
-func (r *Repository) DeleteBlogByID(blogID uuid.UUID) error {
+// DeleteBlogByID blogu çöp kutusuna taşır (status = deleted). Silen kullanıcı, zaman ve
+// geri yüklemede dönülecek önceki durum saklanır. Zaten silinmiş bloglar için hata döner.
+func (r *Repository) DeleteBlogByID(blogID uuid.UUID, deletedBy uuid.UUID) error {
 	defer utils.TimeTrack(time.Now(), "Blog -> Delete Blog By ID")
 
-	// Begin a transaction for this operation
-	tx, err := r.db.Begin()
-	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
-	}
-
-	// Rollback transaction on error
-	defer func() {
-		if err != nil {
-			tx.Rollback()
-		}
-	}()
-
-	// Update the blog status to deleted
 	query := `
 		UPDATE blog_posts
-		SET status = $1, updated_at = $2
-		WHERE id = $3
+		SET status_before_delete = status, status = $1, deleted_at = $2, deleted_by = $3, updated_at = $2
+		WHERE id = $4 AND status != $1
 	`
 
-	_, err = tx.Exec(query, types.BlogStatusDeleted, time.Now(), blogID)
+	result, err := r.db.Exec(query, types.BlogStatusDeleted, time.Now(), deletedBy, blogID)
 	if err != nil {
 		return fmt.Errorf("failed to mark blog as deleted: %w", err)
 	}
 
-	// Commit the transaction
-	if err = tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error checking rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("blog with ID %s not found or already deleted", blogID)
 	}
 
 	return nil
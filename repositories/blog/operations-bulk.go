@@ -0,0 +1,200 @@
+package BlogRepository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// bulkItemError tek bir blogun toplu işlemde neden atlandığını açıklar (transaction'ı bozmaz)
+type bulkItemError struct {
+	code    string
+	message string
+}
+
+func (e *bulkItemError) Error() string {
+	return e.message
+}
+
+// ApplyBlogBulkOperation seçilen bloglara tek bir transaction içinde toplu işlem uygular.
+// Her blog kendi savepoint'i içinde işlenir; herhangi bir blog başarısız olursa veya DryRun
+// istenmişse tüm değişiklikler geri alınır ve sadece rapor döner.
+// ownerID nil değilse yalnızca bu kullanıcıya ait bloglar işlenir ("own" erişim seviyesi).
+func (r *Repository) ApplyBlogBulkOperation(input types.BlogBulkInput, ids []uuid.UUID, actorID uuid.UUID, ownerID *uuid.UUID) (*types.BlogBulkReport, error) {
+	defer utils.TimeTrack(time.Now(), "Blog -> Apply Blog Bulk Operation")
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	report := &types.BlogBulkReport{
+		Action: input.Action,
+		DryRun: input.DryRun,
+		Total:  len(ids),
+		Items:  make([]types.BlogBulkItemResult, 0, len(ids)),
+	}
+
+	for _, id := range ids {
+		item := types.BlogBulkItemResult{ID: id}
+
+		if _, err := tx.Exec("SAVEPOINT bulk_item"); err != nil {
+			return nil, fmt.Errorf("failed to create savepoint: %w", err)
+		}
+
+		changed, itemErr := r.applyBulkItem(tx, input, &item, actorID, ownerID)
+		if itemErr != nil {
+			if _, err := tx.Exec("ROLLBACK TO SAVEPOINT bulk_item"); err != nil {
+				return nil, fmt.Errorf("failed to rollback savepoint: %w", err)
+			}
+
+			item.Result = types.BlogBulkResultFailed
+			var bulkErr *bulkItemError
+			if errors.As(itemErr, &bulkErr) {
+				item.Error = bulkErr.code
+				item.Message = bulkErr.message
+			} else {
+				item.Error = "database_error"
+				item.Message = itemErr.Error()
+			}
+			report.Failed++
+		} else if changed {
+			item.Result = types.BlogBulkResultUpdated
+			report.Updated++
+		} else {
+			item.Result = types.BlogBulkResultUnchanged
+			report.Unchanged++
+		}
+
+		if _, err := tx.Exec("RELEASE SAVEPOINT bulk_item"); err != nil {
+			return nil, fmt.Errorf("failed to release savepoint: %w", err)
+		}
+
+		report.Items = append(report.Items, item)
+	}
+
+	if input.DryRun || report.Failed > 0 {
+		return report, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	report.Committed = true
+
+	return report, nil
+}
+
+// applyBulkItem tek bir bloga işlemi uygular ve bir değişiklik olup olmadığını döndürür
+func (r *Repository) applyBulkItem(tx *sql.Tx, input types.BlogBulkInput, item *types.BlogBulkItemResult, actorID uuid.UUID, ownerID *uuid.UUID) (bool, error) {
+	var blogOwnerID uuid.UUID
+	selectQuery := `
+		SELECT slug, language, status, user_id
+		FROM blog_posts
+		WHERE id = $1
+		FOR UPDATE
+	`
+	err := tx.QueryRow(selectQuery, item.ID).Scan(&item.Slug, &item.Language, &item.Previous, &blogOwnerID)
+	if err == sql.ErrNoRows {
+		return false, &bulkItemError{"blog_not_found", "Blog yazısı bulunamadı."}
+	}
+	if err != nil {
+		return false, fmt.Errorf("error selecting blog: %w", err)
+	}
+
+	if ownerID != nil && *ownerID != blogOwnerID {
+		return false, &bulkItemError{"forbidden", "Bu blog yazısı üzerinde işlem yapma yetkiniz yok."}
+	}
+
+	if item.Previous == types.BlogStatusDeleted {
+		return false, &bulkItemError{"blog_in_trash", "Blog yazısı çöp kutusunda."}
+	}
+
+	now := time.Now()
+
+	switch input.Action {
+	case types.BlogBulkStatus:
+		if item.Previous == input.Status {
+			return false, nil
+		}
+
+		query := `UPDATE blog_posts SET status = $1, updated_at = $2, scheduled_at = NULL WHERE id = $3`
+		if input.Status == types.BlogStatusPublished {
+			query = `UPDATE blog_posts SET status = $1, updated_at = $2, published_at = $2, scheduled_at = NULL WHERE id = $3`
+		}
+		return execChanged(tx, query, input.Status, now, item.ID)
+
+	case types.BlogBulkAddTags:
+		return execChanged(tx, `
+			INSERT INTO blog_tags (blog_id, tag_name)
+			SELECT $1::uuid, UNNEST($2::text[])
+			ON CONFLICT DO NOTHING
+		`, item.ID, pq.Array(input.Tags))
+
+	case types.BlogBulkRemoveTags:
+		return execChanged(tx, `DELETE FROM blog_tags WHERE blog_id = $1 AND tag_name = ANY($2)`, item.ID, pq.Array(input.Tags))
+
+	case types.BlogBulkAddCategories:
+		return execChanged(tx, `
+			INSERT INTO blog_categories (blog_id, category_name)
+			SELECT $1::uuid, UNNEST($2::text[])
+			ON CONFLICT DO NOTHING
+		`, item.ID, pq.Array(input.Categories))
+
+	case types.BlogBulkRemoveCategories:
+		return execChanged(tx, `DELETE FROM blog_categories WHERE blog_id = $1 AND category_name = ANY($2)`, item.ID, pq.Array(input.Categories))
+
+	case types.BlogBulkFeature:
+		if item.Previous != types.BlogStatusPublished {
+			return false, &bulkItemError{"blog_not_published", "Yalnızca yayındaki yazılar öne çıkarılabilir."}
+		}
+
+		// Aynı dildeki listenin sonuna eklenir (100'er artışla)
+		return execChanged(tx, `
+			INSERT INTO blog_featured (blog_id, language, position)
+			SELECT $1::uuid, $2::text, COALESCE(MAX(position), 0) + 100
+			FROM blog_featured
+			WHERE language = $2::text
+			HAVING NOT EXISTS (SELECT 1 FROM blog_featured WHERE blog_id = $1::uuid)
+		`, item.ID, item.Language)
+
+	case types.BlogBulkUnfeature:
+		return execChanged(tx, `DELETE FROM blog_featured WHERE blog_id = $1`, item.ID)
+
+	case types.BlogBulkDelete:
+		return execChanged(tx, `
+			UPDATE blog_posts
+			SET status_before_delete = status, status = 'deleted', deleted_at = $1, deleted_by = $2, updated_at = $1
+			WHERE id = $3
+		`, now, actorID, item.ID)
+	}
+
+	return false, &bulkItemError{"invalid_action", "Geçersiz toplu işlem."}
+}
+
+// execChanged sorguyu çalıştırır ve en az bir satırın etkilenip etkilenmediğini döndürür.
+// Yabancı anahtar hataları (olmayan etiket/kategori) blog bazında raporlanır.
+func execChanged(tx *sql.Tx, query string, args ...any) (bool, error) {
+	result, err := tx.Exec(query, args...)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+			return false, &bulkItemError{"invalid_reference", "Geçersiz referans. " + pqErr.Detail}
+		}
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error checking rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
@@ -0,0 +1,287 @@
+package BlogRepository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// UpsertBlogDraft blogun çalışma kopyasını kaydeder. Canlı içeriğe dokunmaz.
+func (r *Repository) UpsertBlogDraft(blogID uuid.UUID, userID uuid.UUID, input types.BlogDraftInput) (*types.BlogDraft, error) {
+	defer utils.TimeTrack(time.Now(), "Blog -> Upsert Blog Draft")
+
+	payload, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding blog draft: %w", err)
+	}
+
+	query := `
+		INSERT INTO blog_drafts (blog_id, user_id, payload, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $4)
+		ON CONFLICT (blog_id) DO UPDATE
+		SET user_id = EXCLUDED.user_id, payload = EXCLUDED.payload, updated_at = EXCLUDED.updated_at
+		RETURNING created_at, updated_at
+	`
+
+	draft := types.BlogDraft{
+		BlogID: blogID,
+		UserID: &userID,
+		Draft:  input,
+	}
+
+	err = r.db.QueryRow(query, blogID, userID, payload, time.Now()).Scan(&draft.CreatedAt, &draft.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("error saving blog draft: %w", err)
+	}
+
+	return &draft, nil
+}
+
+// SelectBlogDraft blogun çalışma kopyasını getirir. Taslak yoksa nil döner.
+func (r *Repository) SelectBlogDraft(blogID uuid.UUID) (*types.BlogDraft, error) {
+	defer utils.TimeTrack(time.Now(), "Blog -> Select Blog Draft")
+
+	query := `
+		SELECT bd.blog_id, bd.user_id, COALESCE(u.username, ''), bd.payload, bd.created_at, bd.updated_at
+		FROM blog_drafts bd
+		LEFT JOIN users u ON bd.user_id = u.id
+		WHERE bd.blog_id = $1
+	`
+
+	var draft types.BlogDraft
+	var userID uuid.NullUUID
+	var payload []byte
+
+	err := r.db.QueryRow(query, blogID).Scan(
+		&draft.BlogID,
+		&userID,
+		&draft.Username,
+		&payload,
+		&draft.CreatedAt,
+		&draft.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error retrieving blog draft: %w", err)
+	}
+
+	if err := json.Unmarshal(payload, &draft.Draft); err != nil {
+		return nil, fmt.Errorf("error decoding blog draft: %w", err)
+	}
+
+	if userID.Valid {
+		draft.UserID = &userID.UUID
+	}
+
+	return &draft, nil
+}
+
+// DeleteBlogDraft blogun çalışma kopyasını siler (değişiklikleri iptal eder)
+func (r *Repository) DeleteBlogDraft(blogID uuid.UUID) error {
+	defer utils.TimeTrack(time.Now(), "Blog -> Delete Blog Draft")
+
+	result, err := r.db.Exec(`DELETE FROM blog_drafts WHERE blog_id = $1`, blogID)
+	if err != nil {
+		return fmt.Errorf("error deleting blog draft: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error checking rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("blog draft not found")
+	}
+
+	return nil
+}
+
+// PublishBlogDraft çalışma kopyasını tek bir transaction içinde canlı içeriğe taşır ve taslağı siler.
+// Yayın durumu ve zamanlama canlı blogdaki haliyle korunur.
+func (r *Repository) PublishBlogDraft(blogID uuid.UUID, userID uuid.UUID) (*types.BlogPostView, error) {
+	defer utils.TimeTrack(time.Now(), "Blog -> Publish Blog Draft")
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initiate transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// 1. Taslağı ve canlı blogun durumunu kilitleyerek oku
+	var payload []byte
+	err = tx.QueryRow(`SELECT payload FROM blog_drafts WHERE blog_id = $1 FOR UPDATE`, blogID).Scan(&payload)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("blog draft not found: %w", err)
+		}
+		return nil, fmt.Errorf("error retrieving blog draft: %w", err)
+	}
+
+	var draft types.BlogDraftInput
+	if err = json.Unmarshal(payload, &draft); err != nil {
+		return nil, fmt.Errorf("error decoding blog draft: %w", err)
+	}
+
+	var status types.BlogStatus
+	var scheduledAt sql.NullTime
+	err = tx.QueryRow(`SELECT status, scheduled_at FROM blog_posts WHERE id = $1 FOR UPDATE`, blogID).Scan(&status, &scheduledAt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving blog status: %w", err)
+	}
+
+	input := types.BlogUpdateInput{
+		ID:         blogID.String(),
+		GroupID:    draft.GroupID,
+		Slug:       draft.Slug,
+		Language:   draft.Language,
+		Status:     status,
+		Metadata:   draft.Metadata,
+		Content:    draft.Content,
+		Categories: draft.Categories,
+		Tags:       draft.Tags,
+	}
+	if scheduledAt.Valid {
+		input.ScheduledAt = &scheduledAt.Time
+	}
+
+	// 2. Canlı içeriği güncelle ve revizyon oluştur
+	err = r.applyBlogUpdate(tx, blogID, input, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Taslağı sil
+	_, err = tx.Exec(`DELETE FROM blog_drafts WHERE blog_id = $1`, blogID)
+	if err != nil {
+		return nil, fmt.Errorf("error deleting published blog draft: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	blogPost, err := r.SelectBlogByID(blogID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve published blog post: %w", err)
+	}
+
+	return blogPost, nil
+}
+
+// SelectBlogDraftView canlı blogun üzerine çalışma kopyasını uygulayarak editör görünümünü oluşturur.
+// Taslak yoksa canlı versiyon ve nil taslak döner.
+func (r *Repository) SelectBlogDraftView(blogID uuid.UUID) (*types.BlogPostView, *types.BlogDraft, error) {
+	defer utils.TimeTrack(time.Now(), "Blog -> Select Blog Draft View")
+
+	blog, err := r.SelectBlogByID(blogID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	draft, err := r.SelectBlogDraft(blogID)
+	if err != nil || draft == nil {
+		return blog, nil, err
+	}
+
+	categories, err := r.selectCategoryViews(draft.Draft.Categories)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tags, err := r.selectTagViews(draft.Draft.Tags)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blog.GroupID = draft.Draft.GroupID
+	blog.Slug = draft.Draft.Slug
+	blog.Language = draft.Draft.Language
+	blog.Metadata = types.MetadataView{
+		Title:       draft.Draft.Metadata.Title,
+		Description: draft.Draft.Metadata.Description,
+		Image:       draft.Draft.Metadata.Image,
+	}
+	// Taslakta hesaplanmış alanlar saklanmaz, görünüm için yeniden üretilir
+	stats := utils.AnalyzeTipTapContent(draft.Draft.Content.JSON, draft.Draft.Language)
+	readTime := stats.ReadTime
+	if draft.Draft.Content.ReadTimeOverride && draft.Draft.Content.ReadTime > 0 {
+		readTime = draft.Draft.Content.ReadTime
+	}
+
+	blog.Content = types.ContentView{
+		Title:            draft.Draft.Content.Title,
+		Description:      draft.Draft.Content.Description,
+		Image:            draft.Draft.Content.Image,
+		ReadTime:         readTime,
+		ReadTimeOverride: draft.Draft.Content.ReadTimeOverride,
+		WordCount:        stats.WordCount,
+		HTML:             draft.Draft.Content.HTML,
+		JSON:             draft.Draft.Content.JSON,
+	}
+	blog.TOC = stats.TOC
+	blog.Categories = categories
+	blog.Tags = tags
+	blog.UpdatedAt = draft.UpdatedAt
+
+	return blog, draft, nil
+}
+
+func (r *Repository) selectCategoryViews(names []string) ([]types.CategoryView, error) {
+	categories := []types.CategoryView{}
+	if len(names) == 0 {
+		return categories, nil
+	}
+
+	rows, err := r.db.Query(`SELECT name, value FROM categories WHERE name = ANY($1)`, pq.Array(names))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving draft categories: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var category types.CategoryView
+		if err := rows.Scan(&category.Name, &category.Value); err != nil {
+			return nil, fmt.Errorf("error scanning draft category: %w", err)
+		}
+		categories = append(categories, category)
+	}
+
+	return categories, rows.Err()
+}
+
+func (r *Repository) selectTagViews(names []string) ([]types.TagView, error) {
+	tags := []types.TagView{}
+	if len(names) == 0 {
+		return tags, nil
+	}
+
+	rows, err := r.db.Query(`SELECT name, value FROM tags WHERE name = ANY($1)`, pq.Array(names))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving draft tags: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tag types.TagView
+		if err := rows.Scan(&tag.Name, &tag.Value); err != nil {
+			return nil, fmt.Errorf("error scanning draft tag: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+
+	return tags, rows.Err()
+}
@@ -0,0 +1,168 @@
+package BlogRepository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+var (
+	ErrBlogNotFound           = errors.New("blog not found")
+	ErrBlogGroupNotFound      = errors.New("blog group not found")
+	ErrBlogGroupLanguageTaken = errors.New("blog group already has a post in this language")
+)
+
+// SelectBlogGroup çeviri grubundaki blogları dil sırasına göre döndürür (çöp kutusundakiler hariç)
+func (r *Repository) SelectBlogGroup(groupID string) ([]types.BlogGroupMember, error) {
+	defer utils.TimeTrack(time.Now(), "Blog -> Select Blog Group")
+
+	query := `
+		SELECT bp.id, bp.slug, bp.language, bp.status, COALESCE(bc.title, ''), bp.updated_at, bp.published_at
+		FROM blog_posts bp
+		LEFT JOIN blog_content bc ON bc.id = bp.id
+		WHERE bp.group_id = $1 AND bp.status != 'deleted'
+		ORDER BY bp.language
+	`
+
+	rows, err := r.db.Query(query, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("error querying blog group: %w", err)
+	}
+	defer rows.Close()
+
+	members := []types.BlogGroupMember{}
+	for rows.Next() {
+		var member types.BlogGroupMember
+		var publishedAt sql.NullTime
+
+		err := rows.Scan(
+			&member.ID,
+			&member.Slug,
+			&member.Language,
+			&member.Status,
+			&member.Title,
+			&member.UpdatedAt,
+			&publishedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning blog group member: %w", err)
+		}
+
+		if publishedAt.Valid {
+			member.PublishedAt = &publishedAt.Time
+		}
+
+		members = append(members, member)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error processing blog group rows: %w", err)
+	}
+
+	return members, nil
+}
+
+// SelectBlogLanguages bloglarda kullanılan tüm dilleri döndürür (çöp kutusundakiler hariç)
+func (r *Repository) SelectBlogLanguages() ([]string, error) {
+	defer utils.TimeTrack(time.Now(), "Blog -> Select Blog Languages")
+
+	var languages []string
+	query := `SELECT ARRAY(SELECT DISTINCT language FROM blog_posts WHERE status != 'deleted' ORDER BY language)`
+	if err := r.db.QueryRow(query).Scan(pq.Array(&languages)); err != nil {
+		return nil, fmt.Errorf("error selecting blog languages: %w", err)
+	}
+
+	return languages, nil
+}
+
+// MoveBlogToGroup blogu belirtilen çeviri grubuna taşır. requireExisting true ise grup en az bir
+// blog içermelidir. Grupta aynı dilde başka bir blog varsa ErrBlogGroupLanguageTaken döner.
+// Taşınmadan önceki grup ID'si döndürülür.
+func (r *Repository) MoveBlogToGroup(blogID uuid.UUID, groupID string, requireExisting bool) (string, error) {
+	defer utils.TimeTrack(time.Now(), "Blog -> Move Blog To Group")
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var previousGroupID, language string
+	err = tx.QueryRow(`
+		SELECT group_id, language
+		FROM blog_posts
+		WHERE id = $1 AND status != 'deleted'
+		FOR UPDATE
+	`, blogID).Scan(&previousGroupID, &language)
+	if err == sql.ErrNoRows {
+		return "", ErrBlogNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("error selecting blog: %w", err)
+	}
+
+	if requireExisting {
+		var exists bool
+		err = tx.QueryRow(`SELECT EXISTS(SELECT 1 FROM blog_posts WHERE group_id = $1 AND status != 'deleted')`, groupID).Scan(&exists)
+		if err != nil {
+			return "", fmt.Errorf("error checking blog group: %w", err)
+		}
+		if !exists {
+			return "", ErrBlogGroupNotFound
+		}
+	}
+
+	var taken bool
+	err = tx.QueryRow(`
+		SELECT EXISTS(
+			SELECT 1 FROM blog_posts
+			WHERE group_id = $1 AND language = $2 AND id != $3 AND status != 'deleted'
+		)
+	`, groupID, language, blogID).Scan(&taken)
+	if err != nil {
+		return "", fmt.Errorf("error checking blog group language: %w", err)
+	}
+	if taken {
+		return "", ErrBlogGroupLanguageTaken
+	}
+
+	_, err = tx.Exec(`UPDATE blog_posts SET group_id = $1, updated_at = $2 WHERE id = $3`, groupID, time.Now(), blogID)
+	if err != nil {
+		return "", fmt.Errorf("error moving blog to group: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return "", fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return previousGroupID, nil
+}
+
+// GenerateDetachedGroupID gruptan ayrılan blog için kullanılmayan bir grup ID'si üretir.
+// Öncelik blogun slug'ıdır; slug başka bir grup tarafından kullanılıyorsa blog ID'sinin ilk 8 karakteri eklenir.
+func (r *Repository) GenerateDetachedGroupID(blogID uuid.UUID) (string, error) {
+	defer utils.TimeTrack(time.Now(), "Blog -> Generate Detached Group ID")
+
+	var slug string
+	var inUse bool
+	err := r.db.QueryRow(`
+		SELECT bp.slug, EXISTS(SELECT 1 FROM blog_posts other WHERE other.group_id = bp.slug AND other.id != bp.id)
+		FROM blog_posts bp
+		WHERE bp.id = $1
+	`, blogID).Scan(&slug, &inUse)
+	if err != nil {
+		return "", fmt.Errorf("error generating group id: %w", err)
+	}
+
+	if inUse {
+		return slug + "-" + blogID.String()[:8], nil
+	}
+
+	return slug, nil
+}
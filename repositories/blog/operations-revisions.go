@@ -0,0 +1,228 @@
+package BlogRepository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// CreateBlogRevision blogun transaction içindeki güncel halini yeni bir revizyon olarak kaydeder.
+// Create/Update işlemlerinin sonunda, aynı transaction içinde çağrılmalıdır.
+func (r *Repository) CreateBlogRevision(tx *sql.Tx, blogID uuid.UUID, userID uuid.UUID) error {
+	defer utils.TimeTrack(time.Now(), "Blog -> Create Blog Revision")
+
+	// Aynı blog için eşzamanlı kayıtlar aynı revision_number'ı hesaplamasın diye blog satırı kilitlenir;
+	// kilit transaction bitene kadar tutulur, bekleyen kayıt MAX değerini commit sonrası görür.
+	var lockedID uuid.UUID
+	err := tx.QueryRow(`SELECT id FROM blog_posts WHERE id = $1 FOR UPDATE`, blogID).Scan(&lockedID)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("blog with ID %s not found for revision", blogID)
+	}
+	if err != nil {
+		return fmt.Errorf("error locking blog for revision: %w", err)
+	}
+
+	query := `
+		INSERT INTO blog_revisions (
+			blog_id, revision_number, user_id,
+			group_id, slug, language, status,
+			meta_title, meta_description, meta_image,
+			content_title, content_description, content_image, read_time, html, json,
+			categories, tags
+		)
+		SELECT
+			bp.id,
+			COALESCE((SELECT MAX(br.revision_number) FROM blog_revisions br WHERE br.blog_id = bp.id), 0) + 1,
+			$2,
+			bp.group_id, bp.slug, bp.language, bp.status,
+			bm.title, bm.description, bm.image,
+			bc.title, bc.description, bc.image, bc.read_time, bc.html, bc.json,
+			COALESCE((SELECT array_agg(bcat.category_name ORDER BY bcat.category_name) FROM blog_categories bcat WHERE bcat.blog_id = bp.id), '{}'),
+			COALESCE((SELECT array_agg(btag.tag_name ORDER BY btag.tag_name) FROM blog_tags btag WHERE btag.blog_id = bp.id), '{}')
+		FROM blog_posts bp
+		JOIN blog_metadata bm ON bp.id = bm.id
+		JOIN blog_content bc ON bp.id = bc.id
+		WHERE bp.id = $1
+	`
+
+	var revisionUserID any
+	if userID != uuid.Nil {
+		revisionUserID = userID
+	}
+
+	result, err := tx.Exec(query, blogID, revisionUserID)
+	if err != nil {
+		return fmt.Errorf("error creating blog revision: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error checking rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("blog with ID %s not found for revision", blogID)
+	}
+
+	return nil
+}
+
+// SelectBlogRevisions bir bloga ait revizyonları en yeniden eskiye doğru listeler
+func (r *Repository) SelectBlogRevisions(blogID uuid.UUID) ([]types.BlogRevisionSummary, error) {
+	defer utils.TimeTrack(time.Now(), "Blog -> Select Blog Revisions")
+
+	query := `
+		SELECT
+			br.id,
+			br.revision_number,
+			br.user_id,
+			COALESCE(u.username, ''),
+			br.meta_title,
+			br.slug,
+			br.status,
+			br.created_at
+		FROM blog_revisions br
+		LEFT JOIN users u ON br.user_id = u.id
+		WHERE br.blog_id = $1
+		ORDER BY br.revision_number DESC
+	`
+
+	rows, err := r.db.Query(query, blogID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving blog revisions: %w", err)
+	}
+	defer rows.Close()
+
+	revisions := []types.BlogRevisionSummary{}
+	for rows.Next() {
+		var revision types.BlogRevisionSummary
+		var userID uuid.NullUUID
+
+		err := rows.Scan(
+			&revision.ID,
+			&revision.RevisionNumber,
+			&userID,
+			&revision.Username,
+			&revision.Title,
+			&revision.Slug,
+			&revision.Status,
+			&revision.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning blog revision: %w", err)
+		}
+
+		if userID.Valid {
+			revision.UserID = &userID.UUID
+		}
+
+		revisions = append(revisions, revision)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error processing blog revision rows: %w", err)
+	}
+
+	return revisions, nil
+}
+
+// SelectBlogRevisionByID tek bir revizyonu tüm içeriğiyle getirir
+func (r *Repository) SelectBlogRevisionByID(blogID uuid.UUID, revisionID uuid.UUID) (*types.BlogRevision, error) {
+	defer utils.TimeTrack(time.Now(), "Blog -> Select Blog Revision By ID")
+
+	query := `
+		SELECT
+			br.id,
+			br.blog_id,
+			br.revision_number,
+			br.user_id,
+			COALESCE(u.username, ''),
+			br.group_id,
+			br.slug,
+			br.language,
+			br.status,
+			br.meta_title,
+			br.meta_description,
+			br.meta_image,
+			br.content_title,
+			br.content_description,
+			br.content_image,
+			br.read_time,
+			br.html,
+			br.json,
+			br.categories,
+			br.tags,
+			br.created_at
+		FROM blog_revisions br
+		LEFT JOIN users u ON br.user_id = u.id
+		WHERE br.blog_id = $1 AND br.id = $2
+	`
+
+	var revision types.BlogRevision
+	var userID uuid.NullUUID
+	var metaDesc, metaImage, contentDesc, contentImage sql.NullString
+	var categories, tags []string
+
+	err := r.db.QueryRow(query, blogID, revisionID).Scan(
+		&revision.ID,
+		&revision.BlogID,
+		&revision.RevisionNumber,
+		&userID,
+		&revision.Username,
+		&revision.GroupID,
+		&revision.Slug,
+		&revision.Language,
+		&revision.Status,
+		&revision.Metadata.Title,
+		&metaDesc,
+		&metaImage,
+		&revision.Content.Title,
+		&contentDesc,
+		&contentImage,
+		&revision.Content.ReadTime,
+		&revision.Content.HTML,
+		&revision.Content.JSON,
+		pq.Array(&categories),
+		pq.Array(&tags),
+		&revision.CreatedAt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("blog revision not found: %w", err)
+		}
+		return nil, fmt.Errorf("error retrieving blog revision: %w", err)
+	}
+
+	if userID.Valid {
+		revision.UserID = &userID.UUID
+	}
+	if metaDesc.Valid {
+		revision.Metadata.Description = metaDesc.String
+	}
+	if metaImage.Valid {
+		revision.Metadata.Image = metaImage.String
+	}
+	if contentDesc.Valid {
+		revision.Content.Description = contentDesc.String
+	}
+	if contentImage.Valid {
+		revision.Content.Image = contentImage.String
+	}
+
+	revision.Categories = categories
+	revision.Tags = tags
+	if revision.Categories == nil {
+		revision.Categories = []string{}
+	}
+	if revision.Tags == nil {
+		revision.Tags = []string{}
+	}
+
+	return &revision, nil
+}
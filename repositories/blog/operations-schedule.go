@@ -0,0 +1,128 @@
+package BlogRepository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// ScheduleBlogPost blogu belirtilen tarihte yayınlanmak üzere zamanlar (yeniden zamanlama da bu fonksiyonla yapılır).
+// Yayında veya silinmiş olan bloglar zamanlanamaz.
+func (r *Repository) ScheduleBlogPost(blogID uuid.UUID, scheduledAt time.Time) error {
+	defer utils.TimeTrack(time.Now(), "Blog -> Schedule Blog Post")
+
+	query := `
+		UPDATE blog_posts
+		SET status = 'scheduled', scheduled_at = $1, updated_at = $2
+		WHERE id = $3 AND status NOT IN ('published', 'deleted')
+	`
+
+	result, err := r.db.Exec(query, scheduledAt, time.Now(), blogID)
+	if err != nil {
+		return fmt.Errorf("error scheduling blog post: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error checking rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("blog not found or cannot be scheduled")
+	}
+
+	return nil
+}
+
+// CancelScheduledBlogPost zamanlanmış bir blogu tekrar taslak durumuna alır
+func (r *Repository) CancelScheduledBlogPost(blogID uuid.UUID) error {
+	defer utils.TimeTrack(time.Now(), "Blog -> Cancel Scheduled Blog Post")
+
+	query := `
+		UPDATE blog_posts
+		SET status = 'draft', scheduled_at = NULL, updated_at = $1
+		WHERE id = $2 AND status = 'scheduled'
+	`
+
+	result, err := r.db.Exec(query, time.Now(), blogID)
+	if err != nil {
+		return fmt.Errorf("error cancelling scheduled blog post: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error checking rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("scheduled blog not found")
+	}
+
+	return nil
+}
+
+// PublishDueScheduledPosts yayın zamanı gelmiş blogları yayınlar ve yayınlanan blogları döndürür.
+// Dönen blogların ScheduledAt alanı yayınlanmadan önceki zamanlama değeridir.
+func (r *Repository) PublishDueScheduledPosts() ([]types.BlogPost, error) {
+	defer utils.TimeTrack(time.Now(), "Blog -> Publish Due Scheduled Posts")
+
+	query := `
+		UPDATE blog_posts
+		SET status = 'published', published_at = scheduled_at, scheduled_at = NULL, updated_at = $1
+		WHERE status = 'scheduled' AND scheduled_at <= $1
+		RETURNING id, group_id, slug, language, status, created_at, updated_at, published_at
+	`
+
+	rows, err := r.db.Query(query, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("error publishing scheduled blog posts: %w", err)
+	}
+	defer rows.Close()
+
+	posts := []types.BlogPost{}
+	for rows.Next() {
+		var post types.BlogPost
+		var publishedAt sql.NullTime
+
+		err := rows.Scan(
+			&post.ID,
+			&post.GroupID,
+			&post.Slug,
+			&post.Language,
+			&post.Status,
+			&post.CreatedAt,
+			&post.UpdatedAt,
+			&publishedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning published blog post: %w", err)
+		}
+
+		if publishedAt.Valid {
+			post.PublishedAt = publishedAt.Time
+			// scheduled_at NULL'a çekildi; yayın tarihi eski zamanlama değeridir (denetim kaydı için)
+			scheduledAt := publishedAt.Time
+			post.ScheduledAt = &scheduledAt
+		}
+
+		posts = append(posts, post)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error processing published blog rows: %w", err)
+	}
+
+	return posts, nil
+}
+
+// scheduledAtForStatus zamanlama tarihini sadece "scheduled" durumundaki bloglar için döndürür
+func scheduledAtForStatus(status types.BlogStatus, scheduledAt *time.Time) *time.Time {
+	if status != types.BlogStatusScheduled {
+		return nil
+	}
+	return scheduledAt
+}
@@ -0,0 +1,225 @@
+package BlogRepository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// SelectTrashedBlogs çöp kutusundaki blogları en son silinenden başlayarak listeler ve toplam sayıyı döndürür
+func (r *Repository) SelectTrashedBlogs(limit int, offset int) ([]types.TrashedBlog, int, error) {
+	defer utils.TimeTrack(time.Now(), "Blog -> Select Trashed Blogs")
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM blog_posts WHERE status = 'deleted'`
+	if err := r.db.QueryRow(countQuery).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("error counting trashed blogs: %w", err)
+	}
+
+	query := `
+		SELECT
+			bp.id, bp.group_id, bp.slug, bp.language,
+			COALESCE(bc.title, ''), COALESCE(bc.image, ''),
+			COALESCE(bp.status_before_delete, 'draft'),
+			COALESCE(bp.deleted_at, bp.updated_at),
+			bp.deleted_by, COALESCE(u.username, '')
+		FROM blog_posts bp
+		LEFT JOIN blog_content bc ON bc.id = bp.id
+		LEFT JOIN users u ON u.id = bp.deleted_by
+		WHERE bp.status = 'deleted'
+		ORDER BY COALESCE(bp.deleted_at, bp.updated_at) DESC
+		LIMIT $1 OFFSET $2
+	`
+
+	rows, err := r.db.Query(query, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error querying trashed blogs: %w", err)
+	}
+	defer rows.Close()
+
+	blogs := []types.TrashedBlog{}
+	for rows.Next() {
+		var blog types.TrashedBlog
+		var deletedBy uuid.NullUUID
+
+		err := rows.Scan(
+			&blog.ID,
+			&blog.GroupID,
+			&blog.Slug,
+			&blog.Language,
+			&blog.Title,
+			&blog.Image,
+			&blog.StatusBeforeDelete,
+			&blog.DeletedAt,
+			&deletedBy,
+			&blog.DeletedByUsername,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error scanning trashed blog: %w", err)
+		}
+
+		if deletedBy.Valid {
+			blog.DeletedBy = &deletedBy.UUID
+		}
+
+		blogs = append(blogs, blog)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error processing trashed blog rows: %w", err)
+	}
+
+	return blogs, total, nil
+}
+
+// RestoreTrashedBlog çöp kutusundaki blogu silinmeden önceki durumuna döndürür ve bu durumu geri verir
+func (r *Repository) RestoreTrashedBlog(blogID uuid.UUID) (types.BlogStatus, error) {
+	defer utils.TimeTrack(time.Now(), "Blog -> Restore Trashed Blog")
+
+	query := `
+		UPDATE blog_posts
+		SET status = COALESCE(status_before_delete, 'draft'),
+			status_before_delete = NULL, deleted_at = NULL, deleted_by = NULL, updated_at = $1
+		WHERE id = $2 AND status = 'deleted'
+		RETURNING status
+	`
+
+	var status types.BlogStatus
+	err := r.db.QueryRow(query, time.Now(), blogID).Scan(&status)
+	if err == sql.ErrNoRows {
+		return "", ErrBlogNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("error restoring trashed blog: %w", err)
+	}
+
+	return status, nil
+}
+
+// SelectExpiredTrashedBlogIDs belirtilen tarihten önce çöp kutusuna taşınmış blogların ID'lerini döndürür
+func (r *Repository) SelectExpiredTrashedBlogIDs(deletedBefore time.Time, limit int) ([]uuid.UUID, error) {
+	defer utils.TimeTrack(time.Now(), "Blog -> Select Expired Trashed Blog IDs")
+
+	query := `
+		SELECT id
+		FROM blog_posts
+		WHERE status = 'deleted' AND COALESCE(deleted_at, updated_at) < $1
+		ORDER BY COALESCE(deleted_at, updated_at)
+		LIMIT $2
+	`
+
+	rows, err := r.db.Query(query, deletedBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error querying expired trashed blogs: %w", err)
+	}
+	defer rows.Close()
+
+	ids := []uuid.UUID{}
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("error scanning expired trashed blog: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error processing expired trashed blog rows: %w", err)
+	}
+
+	return ids, nil
+}
+
+// PurgeTrashedBlog çöp kutusundaki blogu featured kayıtlarıyla birlikte kalıcı olarak siler.
+// Dönen OrphanImageURLs blogun kapak ve içerik görsellerinden başka hiçbir blog, revizyon veya taslak
+// tarafından kullanılmayanlardır.
+func (r *Repository) PurgeTrashedBlog(blogID uuid.UUID) (*types.PurgedBlog, error) {
+	defer utils.TimeTrack(time.Now(), "Blog -> Purge Trashed Blog")
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	purged := &types.PurgedBlog{ID: blogID}
+	var imageURLs []string
+	var contentJSON string
+
+	selectQuery := `
+		SELECT bp.slug, bp.language,
+			ARRAY_REMOVE(ARRAY[NULLIF(bm.image, ''), NULLIF(bc.image, '')], NULL),
+			COALESCE(bc.json, '')
+		FROM blog_posts bp
+		LEFT JOIN blog_metadata bm ON bm.id = bp.id
+		LEFT JOIN blog_content bc ON bc.id = bp.id
+		WHERE bp.id = $1 AND bp.status = 'deleted'
+		FOR UPDATE OF bp
+	`
+	err = tx.QueryRow(selectQuery, blogID).Scan(&purged.Slug, &purged.Language, pq.Array(&imageURLs), &contentJSON)
+	if err == sql.ErrNoRows {
+		err = fmt.Errorf("trashed blog with ID %s not found", blogID)
+		return nil, err
+	}
+	if err != nil {
+		err = fmt.Errorf("error selecting trashed blog: %w", err)
+		return nil, err
+	}
+
+	// İçerikteki görseller de blogla birlikte silinmeye adaydır
+	imageURLs = append(imageURLs, utils.TipTapImageSources(contentJSON)...)
+
+	if _, err = tx.Exec(`DELETE FROM blog_featured WHERE blog_id = $1`, blogID); err != nil {
+		err = fmt.Errorf("failed to remove featured rows: %w", err)
+		return nil, err
+	}
+
+	// Diğer ilişkili tablolar (metadata, content, stats, categories, tags, revisions, drafts) CASCADE ile silinir
+	if _, err = tx.Exec(`DELETE FROM blog_posts WHERE id = $1`, blogID); err != nil {
+		err = fmt.Errorf("failed to delete blog: %w", err)
+		return nil, err
+	}
+
+	purged.OrphanImageURLs = []string{}
+	if len(imageURLs) > 0 {
+		// Blog satırları silindikten sonra kalan tüm kapaklar, içerikler (html/json), revizyonlar ve taslaklar aranır
+		orphanQuery := `
+			SELECT ARRAY(
+				SELECT DISTINCT url
+				FROM UNNEST($1::text[]) AS url
+				WHERE NOT EXISTS (SELECT 1 FROM blog_metadata WHERE image = url)
+				  AND NOT EXISTS (
+					SELECT 1 FROM blog_content
+					WHERE image = url OR STRPOS(html, url) > 0 OR STRPOS(json, url) > 0
+				  )
+				  AND NOT EXISTS (
+					SELECT 1 FROM blog_revisions
+					WHERE meta_image = url OR content_image = url OR STRPOS(html, url) > 0 OR STRPOS(json, url) > 0
+				  )
+				  AND NOT EXISTS (SELECT 1 FROM blog_drafts WHERE STRPOS(payload::text, url) > 0)
+			)
+		`
+		err = tx.QueryRow(orphanQuery, pq.Array(imageURLs)).Scan(pq.Array(&purged.OrphanImageURLs))
+		if err != nil {
+			err = fmt.Errorf("error selecting orphan images: %w", err)
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		err = fmt.Errorf("failed to commit transaction: %w", err)
+		return nil, err
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,266 @@
+package BlogRepository
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// ts_headline'ın eşleşmeleri sardığı işaretler; HTML escape sonrası <mark> ile değiştirilir
+const (
+	searchHighlightStart = "⟦"
+	searchHighlightStop  = "⟧"
+)
+
+// SearchBlogs yayınlanmış bloglarda başlık, açıklama ve gövde metni üzerinde dile duyarlı tam metin araması yapar.
+// Her kelime önek olarak eşleşir (dub → dubai), yazım hataları başlık/açıklama üzerinde trigram benzerliğiyle yakalanır. Sonuçlar puana göre sıralanır,
+// facet'ler sayfalamadan bağımsız olarak tüm eşleşmeler üzerinden hesaplanır.
+func (r *Repository) SearchBlogs(options types.BlogSearchOptions) (*types.BlogSearchPage, error) {
+	defer utils.TimeTrack(time.Now(), "Blog -> Search Blogs")
+
+	page := &types.BlogSearchPage{
+		Results: []types.BlogSearchResult{},
+		Facets: types.BlogSearchFacets{
+			Categories: []types.BlogSearchFacet{},
+			Tags:       []types.BlogSearchFacet{},
+			Languages:  []types.BlogSearchFacet{},
+		},
+	}
+
+	terms := searchTerms(options.Query)
+	if len(terms) == 0 {
+		return page, nil
+	}
+
+	// $1: önek eşleşmeli tsquery, $2: trigram için ham sorgu
+	params := []any{buildPrefixTSQuery(terms), strings.Join(terms, " ")}
+	conditions := []string{"bp.status = 'published'"}
+
+	// Sorgu, vektörler gibi blogun diline göre seçilen yapılandırmayla ayrıştırılır.
+	// Dil filtresi varsa yapılandırma sabit olur ve GIN indeksi kullanılabilir.
+	tsQuery := "to_tsquery(blog_search_config(bp.language), $1)"
+	if options.Language != "" {
+		params = append(params, options.Language)
+		conditions = append(conditions, fmt.Sprintf("bp.language = $%d", len(params)))
+		tsQuery = fmt.Sprintf("to_tsquery(blog_search_config($%d), $1)", len(params))
+	}
+	conditions = append(conditions, `(bc.search_vector @@ `+tsQuery+` OR bp.search_vector @@ `+tsQuery+`
+			OR $2 <% bc.title OR $2 <% COALESCE(bc.description, ''))`)
+	if options.Category != "" {
+		params = append(params, options.Category)
+		conditions = append(conditions, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM blog_categories fc WHERE fc.blog_id = bp.id AND fc.category_name = $%d)", len(params)))
+	}
+	if options.Tag != "" {
+		params = append(params, options.Tag)
+		conditions = append(conditions, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM blog_tags ft WHERE ft.blog_id = bp.id AND ft.tag_name = $%d)", len(params)))
+	}
+
+	matched := `
+		WITH matched AS (
+			SELECT
+				bp.id,
+				bp.language,
+				ts_rank(COALESCE(bc.search_vector, ''::tsvector), ` + tsQuery + `) * 2
+					+ ts_rank(COALESCE(bp.search_vector, ''::tsvector), ` + tsQuery + `)
+					+ word_similarity($2, bc.title) AS rank
+			FROM blog_posts bp
+			JOIN blog_content bc ON bc.id = bp.id
+			WHERE ` + strings.Join(conditions, " AND ") + `
+		)`
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// <% operatörünün eşiği yalnızca bu transaction için düşürülür
+	_, err = tx.Exec(fmt.Sprintf("SET LOCAL pg_trgm.word_similarity_threshold = %v", configs.BLOG_SEARCH_TRGM_THRESHOLD))
+	if err != nil {
+		return nil, fmt.Errorf("error setting similarity threshold: %w", err)
+	}
+
+	// 1. Toplam sayı ve facet'ler
+	facetQuery := matched + `
+		SELECT
+			(SELECT COUNT(*) FROM matched),
+			(
+				SELECT COALESCE(json_agg(f ORDER BY f.count DESC, f.name), '[]'::json)
+				FROM (
+					SELECT c.name, c.value, COUNT(*) AS count
+					FROM matched m
+					JOIN blog_categories x ON x.blog_id = m.id
+					JOIN categories c ON c.name = x.category_name
+					GROUP BY c.name, c.value
+				) f
+			),
+			(
+				SELECT COALESCE(json_agg(f ORDER BY f.count DESC, f.name), '[]'::json)
+				FROM (
+					SELECT t.name, t.value, COUNT(*) AS count
+					FROM matched m
+					JOIN blog_tags x ON x.blog_id = m.id
+					JOIN tags t ON t.name = x.tag_name
+					GROUP BY t.name, t.value
+				) f
+			),
+			(
+				SELECT COALESCE(json_agg(f ORDER BY f.count DESC, f.name), '[]'::json)
+				FROM (
+					SELECT m.language AS name, m.language AS value, COUNT(*) AS count
+					FROM matched m
+					GROUP BY m.language
+				) f
+			)
+	`
+
+	var categoriesJSON, tagsJSON, languagesJSON []byte
+	err = tx.QueryRow(facetQuery, params...).Scan(&page.Total, &categoriesJSON, &tagsJSON, &languagesJSON)
+	if err != nil {
+		return nil, fmt.Errorf("search facet query failed: %w", err)
+	}
+
+	if err := json.Unmarshal(categoriesJSON, &page.Facets.Categories); err != nil {
+		return nil, fmt.Errorf("error unmarshalling category facets: %w", err)
+	}
+	if err := json.Unmarshal(tagsJSON, &page.Facets.Tags); err != nil {
+		return nil, fmt.Errorf("error unmarshalling tag facets: %w", err)
+	}
+	if err := json.Unmarshal(languagesJSON, &page.Facets.Languages); err != nil {
+		return nil, fmt.Errorf("error unmarshalling language facets: %w", err)
+	}
+
+	if page.Total == 0 || options.Offset >= page.Total {
+		return page, tx.Commit()
+	}
+
+	// 2. Sayfadaki sonuçlar ve vurgulanmış parçalar
+	headlineOptions := fmt.Sprintf("StartSel=%s, StopSel=%s", searchHighlightStart, searchHighlightStop)
+	params = append(params, headlineOptions+", HighlightAll=true", headlineOptions+", MaxWords=35, MinWords=15, MaxFragments=2")
+	titleOptions, descOptions := len(params)-1, len(params)
+
+	params = append(params, options.Limit, options.Offset)
+	limitParam, offsetParam := len(params)-1, len(params)
+
+	dataQuery := matched + fmt.Sprintf(`
+		SELECT
+			bp.id,
+			bp.group_id,
+			bp.slug,
+			bp.language,
+			bp.status,
+			bp.created_at,
+			bp.updated_at,
+			bc.title,
+			COALESCE(bc.description, ''),
+			COALESCE(bc.image, ''),
+			bc.read_time,
+			CASE WHEN bf.blog_id IS NOT NULL THEN true ELSE false END AS featured,
+			(
+				SELECT COALESCE(json_agg(json_build_object('name', c.name, 'value', c.value)), '[]'::json)
+				FROM blog_categories bc2
+				JOIN categories c ON bc2.category_name = c.name
+				WHERE bc2.blog_id = bp.id
+			) AS categories,
+			(
+				SELECT COALESCE(json_agg(json_build_object('name', t.name, 'value', t.value)), '[]'::json)
+				FROM blog_tags bt
+				JOIN tags t ON bt.tag_name = t.name
+				WHERE bt.blog_id = bp.id
+			) AS tags,
+			m.rank,
+			ts_headline(blog_search_config(bp.language), bc.title, to_tsquery(blog_search_config(bp.language), $1), $%d),
+			ts_headline(blog_search_config(bp.language), COALESCE(bc.description, ''), to_tsquery(blog_search_config(bp.language), $1), $%d)
+		FROM matched m
+		JOIN blog_posts bp ON bp.id = m.id
+		JOIN blog_content bc ON bc.id = bp.id
+		LEFT JOIN blog_featured bf ON bp.id = bf.blog_id AND bf.language = bp.language
+		ORDER BY m.rank DESC, bp.published_at DESC NULLS LAST, bp.id
+		LIMIT $%d OFFSET $%d
+	`, titleOptions, descOptions, limitParam, offsetParam)
+
+	rows, err := tx.Query(dataQuery, params...)
+	if err != nil {
+		return nil, fmt.Errorf("search query failed: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var result types.BlogSearchResult
+		var categoriesJSON, tagsJSON []byte
+
+		err := rows.Scan(
+			&result.ID,
+			&result.GroupID,
+			&result.Slug,
+			&result.Language,
+			&result.Status,
+			&result.CreatedAt,
+			&result.UpdatedAt,
+			&result.Content.Title,
+			&result.Content.Description,
+			&result.Content.Image,
+			&result.Content.ReadTime,
+			&result.Featured,
+			&categoriesJSON,
+			&tagsJSON,
+			&result.Rank,
+			&result.Highlights.Title,
+			&result.Highlights.Description,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning search result: %w", err)
+		}
+
+		if err := json.Unmarshal(categoriesJSON, &result.Categories); err != nil {
+			return nil, fmt.Errorf("error unmarshalling categories: %w", err)
+		}
+		if err := json.Unmarshal(tagsJSON, &result.Tags); err != nil {
+			return nil, fmt.Errorf("error unmarshalling tags: %w", err)
+		}
+
+		result.Highlights.Title = markSearchHighlights(result.Highlights.Title)
+		result.Highlights.Description = markSearchHighlights(result.Highlights.Description)
+
+		page.Results = append(page.Results, result)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating search results: %w", err)
+	}
+
+	return page, tx.Commit()
+}
+
+// searchTerms sorguyu küçük harfli harf/rakam dizilerine ayırır (Unicode destekli; "dubai'de" → "dubai", "de")
+func searchTerms(query string) []string {
+	return strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// buildPrefixTSQuery her kelimenin önek olarak eşleştiği bir tsquery metni üretir ("dub:* & mall:*").
+// Kelimeler yalnızca harf ve rakamdan oluştuğu için tsquery sözdizimi enjekte edilemez.
+func buildPrefixTSQuery(terms []string) string {
+	parts := make([]string, len(terms))
+	for i, term := range terms {
+		parts[i] = term + ":*"
+	}
+	return strings.Join(parts, " & ")
+}
+
+// markSearchHighlights ts_headline çıktısını escape eder ve işaretleri <mark> etiketlerine çevirir
+func markSearchHighlights(headline string) string {
+	escaped := html.EscapeString(headline)
+	escaped = strings.ReplaceAll(escaped, searchHighlightStart, "<mark>")
+	return strings.ReplaceAll(escaped, searchHighlightStop, "</mark>")
+}
@@ -0,0 +1,34 @@
+package BlogRepository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// SelectAvailableSlug verilen dilde kullanılmayan bir slug döndürür.
+// Slug alınmışsa sonuna -2, -3 ... eklenerek ilk boş değer bulunur.
+func (r *Repository) SelectAvailableSlug(slug string, language string) (string, error) {
+	defer utils.TimeTrack(time.Now(), "Blog -> Select Available Slug")
+
+	candidate := slug
+	for i := 2; i <= 100; i++ {
+		var taken bool
+		err := r.db.QueryRow(
+			`SELECT EXISTS(SELECT 1 FROM blog_posts WHERE slug = $1 AND language = $2)`,
+			candidate, language,
+		).Scan(&taken)
+		if err != nil {
+			return "", fmt.Errorf("error checking slug availability: %w", err)
+		}
+
+		if !taken {
+			return candidate, nil
+		}
+
+		candidate = fmt.Sprintf("%s-%d", slug, i)
+	}
+
+	return "", fmt.Errorf("no available slug found for %s", slug)
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -13,9 +14,167 @@ import (
 	"github.com/okanay/backend-blog-guideofdubai/utils"
 )
 
-func (r *Repository) SelectBlogCards(options types.BlogCardQueryOptions) ([]types.BlogPostCardView, int, error) {
+// SelectBlogCards filtrelere uyan blog kartlarını ve toplam sayıyı döndürür. options.Cursor verilirse keyset
+// sayfalama kullanılır (derin sayfalarda hızlıdır, yeni yayınlanan bloglar sayfaları kaydırmaz); verilmezse
+// Limit/Offset. Her iki modda da sonraki ve önceki sayfa için imzalı cursor'lar üretilir.
+func (r *Repository) SelectBlogCards(options types.BlogCardQueryOptions) (*types.BlogCardPage, error) {
 	defer utils.TimeTrack(time.Now(), "Blog -> Select Blog Cards")
 
+	sortOption := resolveBlogCardSort(options.SortBy)
+	descending := options.SortDirection != types.SortAsc
+	if options.SortBy == "" {
+		descending = true
+	}
+
+	cursor := options.Cursor
+	var cursorID uuid.UUID
+	if cursor != nil {
+		var err error
+		cursorID, err = uuid.Parse(cursor.ID)
+		// Cursor farklı bir sıralama için üretilmişse konumu anlamsızdır
+		if err != nil || cursor.SortBy != sortOption.name || (cursor.SortDirection == types.SortAsc) == descending {
+			return nil, utils.ErrInvalidCursor
+		}
+	}
+
+	query := buildBlogCardQuery(options, sortOption, descending, cursorID)
+	backward := query.backward
+
+	// Toplam kayıt sayısını çek
+	var total int
+	err := r.db.QueryRow(query.count, query.countParams...).Scan(&total)
+	if err != nil {
+		return nil, fmt.Errorf("count query failed: %w", err)
+	}
+
+	// Ana sorguyu çalıştır
+	rows, err := r.db.Query(query.data, query.params...)
+	if err != nil {
+		return nil, fmt.Errorf("blog card query failed: %w", err)
+	}
+	defer rows.Close()
+
+	var blogCards []types.BlogPostCardView
+	var sortKeys []string
+
+	for rows.Next() {
+		var card types.BlogPostCardView
+		var content types.ContentCardView
+		var categoriesJSON, tagsJSON []byte
+		var sortKey string
+
+		// Scan sırası SQL SELECT sırasıyla aynı olmalı
+		err := rows.Scan(
+			&card.ID,
+			&card.GroupID,
+			&card.Slug,
+			&card.Language,
+			&card.Status,
+			&card.CreatedAt,
+			&card.UpdatedAt,
+			&content.Title,
+			&content.Description,
+			&content.Image,
+			&content.ReadTime,
+			&card.Featured,
+			&categoriesJSON,
+			&tagsJSON,
+			&sortKey,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("error scanning blog card row: %w", err)
+		}
+
+		card.Content = content
+
+		// JSON kategorileri çöz
+		var categories []types.CategoryView
+		if err := json.Unmarshal(categoriesJSON, &categories); err == nil {
+			card.Categories = categories
+		}
+
+		// JSON etiketleri çöz
+		var tags []types.TagView
+		if err := json.Unmarshal(tagsJSON, &tags); err == nil {
+			card.Tags = tags
+		}
+
+		blogCards = append(blogCards, card)
+		sortKeys = append(sortKeys, sortKey)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating through blog cards: %w", err)
+	}
+
+	// Fazladan alınan kaydı at ve geriye doğru sayfayı normal sıraya çevir
+	hasMore := options.Limit > 0 && len(blogCards) > options.Limit
+	if hasMore {
+		blogCards = blogCards[:options.Limit]
+		sortKeys = sortKeys[:options.Limit]
+	}
+	if backward {
+		slices.Reverse(blogCards)
+		slices.Reverse(sortKeys)
+	}
+
+	page := &types.BlogCardPage{
+		Blogs: blogCards,
+		Total: total,
+	}
+
+	if len(blogCards) == 0 || options.Limit <= 0 {
+		return page, nil
+	}
+
+	// Cursor'lar: ileri sayfada "daha fazla" sonraki sayfayı, geri sayfada önceki sayfayı gösterir
+	hasNext, hasPrev := hasMore, cursor != nil || options.Offset > 0
+	if backward {
+		hasNext, hasPrev = true, hasMore
+	}
+
+	direction := types.SortAsc
+	if descending {
+		direction = types.SortDesc
+	}
+	newCursor := func(index int, backward bool) (string, error) {
+		return utils.EncodeBlogCardCursor(types.BlogCardCursor{
+			SortBy:        sortOption.name,
+			SortDirection: direction,
+			Key:           sortKeys[index],
+			ID:            blogCards[index].ID,
+			Backward:      backward,
+		})
+	}
+
+	if hasNext {
+		if page.NextCursor, err = newCursor(len(blogCards)-1, false); err != nil {
+			return nil, fmt.Errorf("error encoding next cursor: %w", err)
+		}
+	}
+	if hasPrev {
+		if page.PrevCursor, err = newCursor(0, true); err != nil {
+			return nil, fmt.Errorf("error encoding prev cursor: %w", err)
+		}
+	}
+
+	return page, nil
+}
+
+// blogCardQuery SelectBlogCards için üretilen COUNT ve ana sorgu ile parametreleri
+type blogCardQuery struct {
+	count       string
+	countParams []any
+	data        string
+	params      []any
+	backward    bool // geriye doğru sayfa; sonuçlar okunduktan sonra ters çevrilir
+}
+
+// buildBlogCardQuery filtrelerden COUNT ve ana sorguyu üretir. Veritabanına erişmez.
+func buildBlogCardQuery(options types.BlogCardQueryOptions, sortOption blogCardSort, descending bool, cursorID uuid.UUID) blogCardQuery {
+	cursor := options.Cursor
+
 	// 1. Önce toplam sayı için COUNT sorgusu oluşturalım
 	countQuery := `
 		SELECT COUNT(DISTINCT bp.id)
@@ -23,7 +182,7 @@ func (r *Repository) SelectBlogCards(options types.BlogCardQueryOptions) ([]type
 	`
 
 	// 2. Ana veri sorgusu (mevcut kodunuzdan)
-	dataQuery := `
+	dataQuery := fmt.Sprintf(`
         SELECT
             bp.id,
             bp.group_id,
@@ -52,11 +211,14 @@ func (r *Repository) SelectBlogCards(options types.BlogCardQueryOptions) ([]type
                 FROM blog_tags bt
                 JOIN tags t ON bt.tag_name = t.name
                 WHERE bt.blog_id = bp.id
-            ) AS tags
+            ) AS tags,
+
+            -- Cursor için sıralama değeri
+            (%s)::text AS sort_key
         FROM blog_posts bp
         LEFT JOIN blog_content bc ON bp.id = bc.id
         LEFT JOIN blog_featured bf ON bp.id = bf.blog_id AND bf.language = bp.language
-    `
+    `, sortOption.expr)
 
 	// Her iki sorgu için filtreleri ve join'leri hazırla
 	var joins []string
@@ -158,6 +320,55 @@ func (r *Repository) SelectBlogCards(options types.BlogCardQueryOptions) ([]type
 		paramCounter++
 	}
 
+	// Kategorilerden herhangi biri
+	if categories := uniqueFilterValues(options.CategoryValues); len(categories) > 0 {
+		conditions = append(conditions, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM blog_categories fc WHERE fc.blog_id = bp.id AND fc.category_name = ANY($%d))", paramCounter))
+		params = append(params, pq.Array(categories))
+		paramCounter++
+	}
+
+	// Etiketlerin tümü
+	if tags := uniqueFilterValues(options.TagValues); len(tags) > 0 {
+		conditions = append(conditions, fmt.Sprintf(
+			"(SELECT COUNT(DISTINCT ft.tag_name) FROM blog_tags ft WHERE ft.blog_id = bp.id AND ft.tag_name = ANY($%d)) = $%d",
+			paramCounter, paramCounter+1))
+		params = append(params, pq.Array(tags), len(tags))
+		paramCounter += 2
+	}
+
+	// Hariç tutulan kategoriler
+	if excluded := uniqueFilterValues(options.ExcludeCategoryValues); len(excluded) > 0 {
+		conditions = append(conditions, fmt.Sprintf(
+			"NOT EXISTS (SELECT 1 FROM blog_categories xc WHERE xc.blog_id = bp.id AND xc.category_name = ANY($%d))", paramCounter))
+		params = append(params, pq.Array(excluded))
+		paramCounter++
+	}
+
+	// Yazar filtresi
+	if options.AuthorID != nil {
+		conditions = append(conditions, fmt.Sprintf("bp.user_id = $%d", paramCounter))
+		params = append(params, *options.AuthorID)
+		paramCounter++
+	}
+
+	// Okuma süresi aralığı filtresi
+	if options.MinReadTime != nil || options.MaxReadTime != nil {
+		if !strings.Contains(countQuery, "JOIN blog_content") {
+			countQuery += " LEFT JOIN blog_content bc ON bp.id = bc.id"
+		}
+		if options.MinReadTime != nil {
+			conditions = append(conditions, fmt.Sprintf("COALESCE(bc.read_time, 0) >= $%d", paramCounter))
+			params = append(params, *options.MinReadTime)
+			paramCounter++
+		}
+		if options.MaxReadTime != nil {
+			conditions = append(conditions, fmt.Sprintf("COALESCE(bc.read_time, 0) <= $%d", paramCounter))
+			params = append(params, *options.MaxReadTime)
+			paramCounter++
+		}
+	}
+
 	// Tarih aralığı filtresi
 	if options.StartDate != nil {
 		conditions = append(conditions, fmt.Sprintf("bp.created_at >= $%d", paramCounter))
@@ -196,134 +407,113 @@ func (r *Repository) SelectBlogCards(options types.BlogCardQueryOptions) ([]type
 
 	// 5. Her iki sorguya da WHERE koşullarını ekle
 	if len(conditions) > 0 {
-		whereClause := " WHERE " + strings.Join(conditions, " AND ")
-		countQuery += whereClause
-		dataQuery += whereClause
-	}
-
-	// 6. Kategori ve etiket birden fazla eşleşme gerektiriyorsa, HAVING ile grup filtrelemesi
-	if options.CategoryValue != "" && options.TagValue != "" {
-		groupByClause := " GROUP BY bp.id, bp.group_id, bp.slug, bp.language, bp.status, bp.created_at, bp.updated_at, bc.title, bc.description, bc.image, bc.read_time, bf.blog_id"
-		countQuery += groupByClause
-		dataQuery += groupByClause
+		countQuery += " WHERE " + strings.Join(conditions, " AND ")
 	}
 
-	// 7. Toplam kayıt sayısını çek
-	// COUNT sorgusu için parametrelerin kopyası (limit ve offset olmadan)
+	// Toplam sayı cursor konumundan bağımsızdır; konum koşulu yalnızca ana sorguya eklenir
+	// COUNT sorgusu için parametrelerin kopyası (cursor, limit ve offset olmadan)
 	countParams := make([]any, len(params))
 	copy(countParams, params)
 
-	var total int
-	err := r.db.QueryRow(countQuery, countParams...).Scan(&total)
-	if err != nil {
-		return nil, 0, fmt.Errorf("count query failed: %w", err)
-	}
+	// Geriye doğru sayfada sıralama ters çevrilir, sonuçlar okunduktan sonra tekrar düzeltilir
+	backward := cursor != nil && cursor.Backward
+	queryDescending := descending != backward
 
-	// 8. Sıralama seçenekleri (sadece ana sorgu için)
-	if options.SortBy != "" {
-		allowedSortColumns := map[string]bool{
-			"created_at": true,
-			"updated_at": true,
-			"title":      true,
-			"views":      true,
-			"likes":      true,
+	if cursor != nil {
+		operator := ">"
+		if queryDescending {
+			operator = "<"
 		}
+		conditions = append(conditions, fmt.Sprintf("(%s, bp.id) %s ($%d::%s, $%d)",
+			sortOption.expr, operator, paramCounter, sortOption.sqlType, paramCounter+1))
+		params = append(params, cursor.Key, cursorID)
+		paramCounter += 2
+	}
 
-		sortColumn := "created_at"
-		if allowedSortColumns[options.SortBy] {
-			if options.SortBy == "created_at" || options.SortBy == "updated_at" {
-				sortColumn = "bp." + options.SortBy
-			} else if options.SortBy == "title" {
-				sortColumn = "bc." + options.SortBy
-			} else if options.SortBy == "views" || options.SortBy == "likes" {
-				if !strings.Contains(dataQuery, "JOIN blog_stats") {
-					dataQuery = strings.Replace(dataQuery, "LEFT JOIN blog_content bc ON bp.id = bc.id",
-						"LEFT JOIN blog_content bc ON bp.id = bc.id LEFT JOIN blog_stats bs ON bp.id = bs.id", 1)
-				}
-				sortColumn = "bs." + options.SortBy
-			}
-		}
+	if len(conditions) > 0 {
+		dataQuery += " WHERE " + strings.Join(conditions, " AND ")
+	}
 
-		sortDirection := "DESC"
-		if options.SortDirection == types.SortAsc {
-			sortDirection = "ASC"
-		}
+	// 6. Kategori ve etiket birden fazla eşleşme gerektiriyorsa, HAVING ile grup filtrelemesi
+	if options.CategoryValue != "" && options.TagValue != "" {
+		groupByClause := " GROUP BY bp.id, bp.group_id, bp.slug, bp.language, bp.status, bp.created_at, bp.updated_at, bc.title, bc.description, bc.image, bc.read_time, bf.blog_id"
+		countQuery += groupByClause
+		dataQuery += groupByClause + ", " + sortOption.expr
+	}
+
+	// 7. Sıralama (sadece ana sorgu için). ID eşit sıralama değerlerinde kararlı bir sıra sağlar.
+	if sortOption.stats && !strings.Contains(dataQuery, "JOIN blog_stats") {
+		dataQuery = strings.Replace(dataQuery, "LEFT JOIN blog_content bc ON bp.id = bc.id",
+			"LEFT JOIN blog_content bc ON bp.id = bc.id LEFT JOIN blog_stats bs ON bp.id = bs.id", 1)
+	}
 
-		dataQuery += fmt.Sprintf(" ORDER BY %s %s", sortColumn, sortDirection)
-	} else {
-		dataQuery += " ORDER BY bp.created_at DESC"
+	sortDirection := "ASC"
+	if queryDescending {
+		sortDirection = "DESC"
 	}
+	dataQuery += fmt.Sprintf(" ORDER BY %s %s, bp.id %s", sortOption.expr, sortDirection, sortDirection)
 
-	// 9. Limit ve Offset (sadece ana sorgu için)
+	// 8. Limit ve Offset (sadece ana sorgu için). Sonraki sayfanın olup olmadığını anlamak için bir fazla kayıt istenir.
 	if options.Limit > 0 {
 		dataQuery += fmt.Sprintf(" LIMIT $%d", paramCounter)
-		params = append(params, options.Limit)
+		params = append(params, options.Limit+1)
 		paramCounter++
 
-		if options.Offset > 0 {
+		if options.Offset > 0 && cursor == nil {
 			dataQuery += fmt.Sprintf(" OFFSET $%d", paramCounter)
 			params = append(params, options.Offset)
 		}
 	}
 
-	// 10. Ana sorguyu çalıştır
-	rows, err := r.db.Query(dataQuery, params...)
-	if err != nil {
-		return nil, 0, fmt.Errorf("blog card query failed: %w", err)
+	return blogCardQuery{
+		count:       countQuery,
+		countParams: countParams,
+		data:        dataQuery,
+		params:      params,
+		backward:    backward,
 	}
-	defer rows.Close()
-
-	var blogCards []types.BlogPostCardView
-
-	for rows.Next() {
-		var card types.BlogPostCardView
-		var content types.ContentCardView
-		var categoriesJSON, tagsJSON []byte
+}
 
-		// Scan sırası SQL SELECT sırasıyla aynı olmalı
-		err := rows.Scan(
-			&card.ID,
-			&card.GroupID,
-			&card.Slug,
-			&card.Language,
-			&card.Status,
-			&card.CreatedAt,
-			&card.UpdatedAt,
-			&content.Title,
-			&content.Description,
-			&content.Image,
-			&content.ReadTime,
-			&card.Featured,
-			&categoriesJSON,
-			&tagsJSON,
-		)
+// blogCardSort bir SortBy seçeneğinin SQL ifadesi ve cursor değerinin tipi.
+// NULL değerler keyset karşılaştırmasını bozacağı için ifadeler COALESCE ile sarılır.
+type blogCardSort struct {
+	name    string
+	expr    string
+	sqlType string
+	stats   bool // blog_stats join'i gerekir
+}
 
-		if err != nil {
-			return nil, 0, fmt.Errorf("error scanning blog card row: %w", err)
-		}
+var blogCardSorts = map[string]blogCardSort{
+	"created_at": {name: "created_at", expr: "bp.created_at", sqlType: "timestamptz"},
+	"updated_at": {name: "updated_at", expr: "bp.updated_at", sqlType: "timestamptz"},
+	"title":      {name: "title", expr: "COALESCE(bc.title, '')", sqlType: "text"},
+	"views":      {name: "views", expr: "COALESCE(bs.views, 0)", sqlType: "integer", stats: true},
+	"likes":      {name: "likes", expr: "COALESCE(bs.likes, 0)", sqlType: "integer", stats: true},
+}
 
-		card.Content = content
+// resolveBlogCardSort bilinmeyen veya boş SortBy için created_at kullanır
+func resolveBlogCardSort(sortBy string) blogCardSort {
+	if sortOption, ok := blogCardSorts[sortBy]; ok {
+		return sortOption
+	}
+	return blogCardSorts["created_at"]
+}
 
-		// JSON kategorileri çöz
-		var categories []types.CategoryView
-		if err := json.Unmarshal(categoriesJSON, &categories); err == nil {
-			card.Categories = categories
-		}
+// uniqueFilterValues boş ve tekrar eden filtre değerlerini atar; "etiketlerin tümü" sayımı tekil değerlere göre yapılır
+func uniqueFilterValues(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	unique := make([]string, 0, len(values))
 
-		// JSON etiketleri çöz
-		var tags []types.TagView
-		if err := json.Unmarshal(tagsJSON, &tags); err == nil {
-			card.Tags = tags
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" || seen[value] {
+			continue
 		}
-
-		blogCards = append(blogCards, card)
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, 0, fmt.Errorf("error iterating through blog cards: %w", err)
+		seen[value] = true
+		unique = append(unique, value)
 	}
 
-	return blogCards, total, nil
+	return unique
 }
 
 func cleanSearchQuery(input string) string {
@@ -0,0 +1,194 @@
+package BlogRepository
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+)
+
+func intPtr(value int) *int {
+	return &value
+}
+
+func TestBuildBlogCardQueryFilters(t *testing.T) {
+	authorID := uuid.MustParse("7f1c1f0e-4a39-4c8e-9a59-1e0c7f0b2d11")
+
+	const (
+		anyCategories = "EXISTS (SELECT 1 FROM blog_categories fc WHERE fc.blog_id = bp.id AND fc.category_name = ANY($%d))"
+		allTags       = "(SELECT COUNT(DISTINCT ft.tag_name) FROM blog_tags ft WHERE ft.blog_id = bp.id AND ft.tag_name = ANY($%d)) = $%d"
+		excluded      = "NOT EXISTS (SELECT 1 FROM blog_categories xc WHERE xc.blog_id = bp.id AND xc.category_name = ANY($%d))"
+		contentJoin   = "LEFT JOIN blog_content bc ON bp.id = bc.id"
+	)
+
+	tests := []struct {
+		name           string
+		options        types.BlogCardQueryOptions
+		wantConditions []string
+		wantParams     []any
+		wantCountJoin  bool // COUNT sorgusunda blog_content join'i olmalı
+	}{
+		{
+			name:           "no filters",
+			options:        types.BlogCardQueryOptions{},
+			wantConditions: []string{"bp.status != 'deleted'"},
+			wantParams:     nil,
+		},
+		{
+			name:           "any categories",
+			options:        types.BlogCardQueryOptions{CategoryValues: []string{"food", "travel"}},
+			wantConditions: []string{sqlf(anyCategories, 1)},
+			wantParams:     []any{pq.Array([]string{"food", "travel"})},
+		},
+		{
+			name:           "any categories drops blanks and duplicates",
+			options:        types.BlogCardQueryOptions{CategoryValues: []string{"food", " ", "food ", ""}},
+			wantConditions: []string{sqlf(anyCategories, 1)},
+			wantParams:     []any{pq.Array([]string{"food"})},
+		},
+		{
+			name:           "all tags",
+			options:        types.BlogCardQueryOptions{TagValues: []string{"beach", "family"}},
+			wantConditions: []string{sqlf(allTags, 1, 2)},
+			wantParams:     []any{pq.Array([]string{"beach", "family"}), 2},
+		},
+		{
+			name:           "all tags counts duplicates once",
+			options:        types.BlogCardQueryOptions{TagValues: []string{"beach", "beach", "family", " family"}},
+			wantConditions: []string{sqlf(allTags, 1, 2)},
+			wantParams:     []any{pq.Array([]string{"beach", "family"}), 2},
+		},
+		{
+			name:           "exclude categories",
+			options:        types.BlogCardQueryOptions{ExcludeCategoryValues: []string{"news"}},
+			wantConditions: []string{sqlf(excluded, 1)},
+			wantParams:     []any{pq.Array([]string{"news"})},
+		},
+		{
+			name:           "author",
+			options:        types.BlogCardQueryOptions{AuthorID: &authorID},
+			wantConditions: []string{"bp.user_id = $1"},
+			wantParams:     []any{authorID},
+		},
+		{
+			name:           "min read time",
+			options:        types.BlogCardQueryOptions{MinReadTime: intPtr(5)},
+			wantConditions: []string{"COALESCE(bc.read_time, 0) >= $1"},
+			wantParams:     []any{5},
+			wantCountJoin:  true,
+		},
+		{
+			name:           "max read time",
+			options:        types.BlogCardQueryOptions{MaxReadTime: intPtr(10)},
+			wantConditions: []string{"COALESCE(bc.read_time, 0) <= $1"},
+			wantParams:     []any{10},
+			wantCountJoin:  true,
+		},
+		{
+			name:           "read time range",
+			options:        types.BlogCardQueryOptions{MinReadTime: intPtr(0), MaxReadTime: intPtr(10)},
+			wantConditions: []string{"COALESCE(bc.read_time, 0) >= $1", "COALESCE(bc.read_time, 0) <= $2"},
+			wantParams:     []any{0, 10},
+			wantCountJoin:  true,
+		},
+		{
+			name: "combined filters are numbered in order",
+			options: types.BlogCardQueryOptions{
+				Language:              "en",
+				CategoryValues:        []string{"food"},
+				TagValues:             []string{"beach", "beach"},
+				ExcludeCategoryValues: []string{"news"},
+				AuthorID:              &authorID,
+				MinReadTime:           intPtr(3),
+				MaxReadTime:           intPtr(8),
+			},
+			wantConditions: []string{
+				"bp.language = $1",
+				sqlf(anyCategories, 2),
+				sqlf(allTags, 3, 4),
+				sqlf(excluded, 5),
+				"bp.user_id = $6",
+				"COALESCE(bc.read_time, 0) >= $7",
+				"COALESCE(bc.read_time, 0) <= $8",
+			},
+			wantParams: []any{
+				"en",
+				pq.Array([]string{"food"}),
+				pq.Array([]string{"beach"}), 1,
+				pq.Array([]string{"news"}),
+				authorID,
+				3, 8,
+			},
+			wantCountJoin: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := buildBlogCardQuery(tt.options, resolveBlogCardSort(""), true, uuid.Nil)
+
+			for _, condition := range tt.wantConditions {
+				if !strings.Contains(query.data, condition) {
+					t.Errorf("data query missing condition %q\n%s", condition, query.data)
+				}
+				if !strings.Contains(query.count, condition) {
+					t.Errorf("count query missing condition %q\n%s", condition, query.count)
+				}
+			}
+
+			if !paramsEqual(query.countParams, tt.wantParams) {
+				t.Errorf("count params = %#v, want %#v", query.countParams, tt.wantParams)
+			}
+			if !paramsEqual(query.params, tt.wantParams) {
+				t.Errorf("data params = %#v, want %#v", query.params, tt.wantParams)
+			}
+
+			if got := strings.Contains(query.count, contentJoin); got != tt.wantCountJoin {
+				t.Errorf("count query blog_content join = %v, want %v\n%s", got, tt.wantCountJoin, query.count)
+			}
+		})
+	}
+}
+
+func TestBuildBlogCardQueryLimit(t *testing.T) {
+	options := types.BlogCardQueryOptions{
+		TagValues:   []string{"beach"},
+		MaxReadTime: intPtr(10),
+		Limit:       12,
+		Offset:      24,
+	}
+
+	query := buildBlogCardQuery(options, resolveBlogCardSort(""), true, uuid.Nil)
+
+	if !strings.Contains(query.data, "LIMIT $4") || !strings.Contains(query.data, "OFFSET $5") {
+		t.Errorf("data query missing LIMIT/OFFSET placeholders\n%s", query.data)
+	}
+	if strings.Contains(query.count, "LIMIT") {
+		t.Errorf("count query must not be limited\n%s", query.count)
+	}
+
+	wantParams := []any{pq.Array([]string{"beach"}), 1, 10, 13, 24}
+	if !reflect.DeepEqual(query.params, wantParams) {
+		t.Errorf("data params = %#v, want %#v", query.params, wantParams)
+	}
+	if !reflect.DeepEqual(query.countParams, wantParams[:3]) {
+		t.Errorf("count params = %#v, want %#v", query.countParams, wantParams[:3])
+	}
+}
+
+// paramsEqual boş ve nil parametre listelerini eşit sayar
+func paramsEqual(got []any, want []any) bool {
+	if len(got) == 0 && len(want) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(got, want)
+}
+
+// sqlf koşul şablonundaki parametre numaralarını doldurur
+func sqlf(format string, numbers ...any) string {
+	return fmt.Sprintf(format, numbers...)
+}
@@ -0,0 +1,26 @@
+package BlogRepository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// SelectBlogOwnerID blogu oluşturan kullanıcının ID'sini döndürür ("own" yetki kontrolü için)
+func (r *Repository) SelectBlogOwnerID(blogID uuid.UUID) (uuid.UUID, error) {
+	defer utils.TimeTrack(time.Now(), "Blog -> Select Blog Owner ID")
+
+	var ownerID uuid.UUID
+	err := r.db.QueryRow(`SELECT user_id FROM blog_posts WHERE id = $1`, blogID).Scan(&ownerID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return uuid.Nil, fmt.Errorf("blog post not found: %w", err)
+		}
+		return uuid.Nil, fmt.Errorf("error retrieving blog owner: %w", err)
+	}
+
+	return ownerID, nil
+}
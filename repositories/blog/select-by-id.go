@@ -25,6 +25,7 @@ func (r *Repository) SelectBlogByID(blogID uuid.UUID) (*types.BlogPostView, erro
             bp.created_at,
             bp.updated_at,
             bp.published_at,
+            bp.scheduled_at,
 
             -- Featured status
             CASE WHEN bf.blog_id IS NOT NULL THEN true ELSE false END as featured,
@@ -39,6 +40,9 @@ func (r *Repository) SelectBlogByID(blogID uuid.UUID) (*types.BlogPostView, erro
             bc.description as content_description,
             bc.image as content_image,
             bc.read_time,
+            COALESCE(bc.read_time_override, false),
+            COALESCE(bc.word_count, 0),
+            COALESCE(bc.toc, '[]'::jsonb),
             bc.html,
             bc.json,
 
@@ -76,9 +80,9 @@ func (r *Repository) SelectBlogByID(blogID uuid.UUID) (*types.BlogPostView, erro
 	var metadata types.MetadataView
 	var content types.ContentView
 	var stats types.StatsView
-	var publishedAt, lastViewedAt sql.NullTime
+	var publishedAt, scheduledAt, lastViewedAt sql.NullTime
 	var metaDesc, metaImage, contentDesc sql.NullString
-	var categoriesJSON, tagsJSON []byte
+	var categoriesJSON, tagsJSON, tocJSON []byte
 
 	err := r.db.QueryRow(query, blogID).Scan(
 		&blog.ID,
@@ -89,6 +93,7 @@ func (r *Repository) SelectBlogByID(blogID uuid.UUID) (*types.BlogPostView, erro
 		&blog.CreatedAt,
 		&blog.UpdatedAt,
 		&publishedAt,
+		&scheduledAt,
 		&blog.Featured,
 
 		&metadata.Title,
@@ -99,6 +104,9 @@ func (r *Repository) SelectBlogByID(blogID uuid.UUID) (*types.BlogPostView, erro
 		&contentDesc,
 		&content.Image,
 		&content.ReadTime,
+		&content.ReadTimeOverride,
+		&content.WordCount,
+		&tocJSON,
 		&content.HTML,
 		&content.JSON,
 
@@ -123,6 +131,9 @@ func (r *Repository) SelectBlogByID(blogID uuid.UUID) (*types.BlogPostView, erro
 	if publishedAt.Valid {
 		blog.PublishedAt = publishedAt.Time
 	}
+	if scheduledAt.Valid {
+		blog.ScheduledAt = &scheduledAt.Time
+	}
 	if metaDesc.Valid {
 		metadata.Description = metaDesc.String
 	}
@@ -153,6 +164,7 @@ func (r *Repository) SelectBlogByID(blogID uuid.UUID) (*types.BlogPostView, erro
 	blog.Stats = stats
 	blog.Categories = categories
 	blog.Tags = tags
+	blog.TOC = decodeTOC(tocJSON)
 
 	return &blog, nil
 }
@@ -185,6 +197,9 @@ func (r *Repository) SelectBlogBody(blogID uuid.UUID) (*types.BlogPostView, erro
             bc.description as content_description,
             bc.image as content_image,
             bc.read_time,
+            COALESCE(bc.read_time_override, false),
+            COALESCE(bc.word_count, 0),
+            COALESCE(bc.toc, '[]'::jsonb),
             bc.html,
             bc.json,
 
@@ -207,6 +222,7 @@ func (r *Repository) SelectBlogBody(blogID uuid.UUID) (*types.BlogPostView, erro
 	var stats types.StatsView
 	var publishedAt, lastViewedAt sql.NullTime
 	var metaDesc, metaImage, contentDesc sql.NullString
+	var tocJSON []byte
 
 	err := r.db.QueryRow(query, blogID).Scan(
 		&blog.ID,
@@ -227,6 +243,9 @@ func (r *Repository) SelectBlogBody(blogID uuid.UUID) (*types.BlogPostView, erro
 		&contentDesc,
 		&content.Image,
 		&content.ReadTime,
+		&content.ReadTimeOverride,
+		&content.WordCount,
+		&tocJSON,
 		&content.HTML,
 		&content.JSON,
 
@@ -268,6 +287,7 @@ func (r *Repository) SelectBlogBody(blogID uuid.UUID) (*types.BlogPostView, erro
 	blog.Metadata = metadata
 	blog.Content = content
 	blog.Stats = stats
+	blog.TOC = decodeTOC(tocJSON)
 
 	return &blog, nil
 }
@@ -51,6 +51,9 @@ func (r *Repository) SelectBlogBySlugID(request types.BlogSelectByGroupIDInput)
             bc.description as content_description,
             bc.image as content_image,
             bc.read_time,
+            COALESCE(bc.read_time_override, false),
+            COALESCE(bc.word_count, 0),
+            COALESCE(bc.toc, '[]'::jsonb),
             bc.html,
             bc.json,
 
@@ -92,7 +95,7 @@ func (r *Repository) SelectBlogBySlugID(request types.BlogSelectByGroupIDInput)
 	var stats types.StatsView
 	var publishedAt, lastViewedAt sql.NullTime
 	var metaDesc, metaImage, contentDesc sql.NullString
-	var categoriesJSON, tagsJSON []byte
+	var categoriesJSON, tagsJSON, tocJSON []byte
 	var groupID string
 
 	err := r.db.QueryRow(query, request.SlugOrGroupID, request.Language).Scan(
@@ -114,6 +117,9 @@ func (r *Repository) SelectBlogBySlugID(request types.BlogSelectByGroupIDInput)
 		&contentDesc,
 		&content.Image,
 		&content.ReadTime,
+		&content.ReadTimeOverride,
+		&content.WordCount,
+		&tocJSON,
 		&content.HTML,
 		&content.JSON,
 
@@ -168,6 +174,7 @@ func (r *Repository) SelectBlogBySlugID(request types.BlogSelectByGroupIDInput)
 	mainPost.Content = content
 	mainPost.Stats = stats
 	mainPost.Categories = categories
+	mainPost.TOC = decodeTOC(tocJSON)
 	mainPost.Tags = tags
 	mainPost.GroupID = groupID
 
@@ -200,6 +207,9 @@ func (r *Repository) SelectBlogBySlugID(request types.BlogSelectByGroupIDInput)
             bc.description as content_description,
             bc.image as content_image,
             bc.read_time,
+            COALESCE(bc.read_time_override, false),
+            COALESCE(bc.word_count, 0),
+            COALESCE(bc.toc, '[]'::jsonb),
             bc.html,
             bc.json,
 
@@ -249,7 +259,7 @@ func (r *Repository) SelectBlogBySlugID(request types.BlogSelectByGroupIDInput)
 		var altStats types.StatsView
 		var altPublishedAt, altLastViewedAt sql.NullTime
 		var altMetaDesc, altMetaImage, altContentDesc sql.NullString
-		var altCategoriesJSON, altTagsJSON []byte
+		var altCategoriesJSON, altTagsJSON, altTOCJSON []byte
 
 		err := rows.Scan(
 			&alt.ID,
@@ -270,6 +280,9 @@ func (r *Repository) SelectBlogBySlugID(request types.BlogSelectByGroupIDInput)
 			&altContentDesc,
 			&altContent.Image,
 			&altContent.ReadTime,
+			&altContent.ReadTimeOverride,
+			&altContent.WordCount,
+			&altTOCJSON,
 			&altContent.HTML,
 			&altContent.JSON,
 
@@ -320,6 +333,7 @@ func (r *Repository) SelectBlogBySlugID(request types.BlogSelectByGroupIDInput)
 		alt.Metadata = altMetadata
 		alt.Content = altContent
 		alt.Stats = altStats
+		alt.TOC = decodeTOC(altTOCJSON)
 
 		alternatives = append(alternatives, &alt)
 	}
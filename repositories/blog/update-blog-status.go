@@ -14,8 +14,8 @@ func (r *Repository) UpdateBlogStatus(blogID uuid.UUID, status types.BlogStatus)
 
 	query := `
 		UPDATE blog_posts
-		SET status = $1, updated_at = $2
-		WHERE id = $3
+		SET status = $1, updated_at = $2, scheduled_at = NULL
+		WHERE id = $3 AND status != 'deleted'
 	`
 
 	// Blog yayınlanıyorsa published_at tarihini güncelle
@@ -25,8 +25,8 @@ func (r *Repository) UpdateBlogStatus(blogID uuid.UUID, status types.BlogStatus)
 	if status == types.BlogStatusPublished {
 		queryWithPublished := `
 			UPDATE blog_posts
-			SET status = $1, updated_at = $2, published_at = $2
-			WHERE id = $3
+			SET status = $1, updated_at = $2, published_at = $2, scheduled_at = NULL
+			WHERE id = $3 AND status != 'deleted'
 		`
 		result, err = r.db.Exec(queryWithPublished, status, time.Now(), blogID)
 	} else {
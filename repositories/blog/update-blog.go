@@ -10,7 +10,7 @@ import (
 	"github.com/okanay/backend-blog-guideofdubai/utils"
 )
 
-func (r *Repository) UpdateBlogPost(input types.BlogUpdateInput) (*types.BlogPostView, error) {
+func (r *Repository) UpdateBlogPost(input types.BlogUpdateInput, userID uuid.UUID) (*types.BlogPostView, error) {
 	defer utils.TimeTrack(time.Now(), "Blog -> Update Blog Post")
 
 	// Blog ID'sini kontrol et
@@ -32,48 +32,65 @@ func (r *Repository) UpdateBlogPost(input types.BlogUpdateInput) (*types.BlogPos
 		}
 	}()
 
+	err = r.applyBlogUpdate(tx, blogID, input, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Transaction'ı commit et
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Güncellenmiş blog post bilgilerini getir
+	blogPost, err := r.SelectBlogByID(blogID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve updated blog post: %w", err)
+	}
+
+	return blogPost, nil
+}
+
+// applyBlogUpdate blogun tüm alanlarını verilen transaction içinde günceller ve yeni bir revizyon kaydeder.
+// Canlı güncelleme ve taslak yayınlama aynı adımları kullanır.
+func (r *Repository) applyBlogUpdate(tx *sql.Tx, blogID uuid.UUID, input types.BlogUpdateInput, userID uuid.UUID) error {
 	// 1. Blog post bilgilerini güncelle
-	err = r.updateBlogPostDetails(tx, blogID, input)
+	err := r.updateBlogPostDetails(tx, blogID, input)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update blog post details: %w", err)
+		return fmt.Errorf("failed to update blog post details: %w", err)
 	}
 
 	// 2. Metadata bilgilerini güncelle
 	err = r.updateBlogMetadata(tx, blogID, input.Metadata)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update blog metadata: %w", err)
+		return fmt.Errorf("failed to update blog metadata: %w", err)
 	}
 
 	// 3. İçerik bilgilerini güncelle
-	err = r.updateBlogContent(tx, blogID, input.Content)
+	err = r.updateBlogContent(tx, blogID, input.Language, input.Content)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update blog content: %w", err)
+		return fmt.Errorf("failed to update blog content: %w", err)
 	}
 
 	// 4. Kategorileri güncelle
 	err = r.updateBlogCategories(tx, blogID, input.Categories)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update blog categories: %w", err)
+		return fmt.Errorf("failed to update blog categories: %w", err)
 	}
 
 	// 5. Etiketleri güncelle
 	err = r.updateBlogTags(tx, blogID, input.Tags)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update blog tags: %w", err)
+		return fmt.Errorf("failed to update blog tags: %w", err)
 	}
 
-	// Transaction'ı commit et
-	if err = tx.Commit(); err != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %w", err)
-	}
-
-	// Güncellenmiş blog post bilgilerini getir
-	blogPost, err := r.SelectBlogByID(blogID)
+	// 6. Güncel hali yeni bir revizyon olarak kaydet
+	err = r.CreateBlogRevision(tx, blogID, userID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve updated blog post: %w", err)
+		return fmt.Errorf("failed to create blog revision: %w", err)
 	}
 
-	return blogPost, nil
+	return nil
 }
 
 func (r *Repository) updateBlogPostDetails(tx *sql.Tx, blogID uuid.UUID, input types.BlogUpdateInput) error {
@@ -82,8 +99,8 @@ func (r *Repository) updateBlogPostDetails(tx *sql.Tx, blogID uuid.UUID, input t
 	// featured alanı kaldırıldı
 	query := `
 		UPDATE blog_posts
-		SET group_id = $1, slug = $2, language = $3, updated_at = $4, status = $5
-		WHERE id = $6
+		SET group_id = $1, slug = $2, language = $3, updated_at = $4, status = $5, scheduled_at = $6
+		WHERE id = $7
 	`
 
 	now := time.Now()
@@ -94,6 +111,7 @@ func (r *Repository) updateBlogPostDetails(tx *sql.Tx, blogID uuid.UUID, input t
 		input.Language,
 		now,
 		input.Status,
+		scheduledAtForStatus(input.Status, input.ScheduledAt),
 		blogID,
 	)
 
@@ -128,22 +146,31 @@ func (r *Repository) updateBlogMetadata(tx *sql.Tx, blogID uuid.UUID, metadata t
 	return nil
 }
 
-func (r *Repository) updateBlogContent(tx *sql.Tx, blogID uuid.UUID, content types.ContentInput) error {
+func (r *Repository) updateBlogContent(tx *sql.Tx, blogID uuid.UUID, language string, content types.ContentInput) error {
 	defer utils.TimeTrack(time.Now(), "Blog -> Update Blog Content")
 
+	stats, readTime, err := resolveContentStats(language, content)
+	if err != nil {
+		return err
+	}
+
 	query := `
 		UPDATE blog_content
-		SET title = $1, description = $2, image = $3, read_time = $4, html = $5, json = $6
-		WHERE id = $7
+		SET title = $1, description = $2, image = $3, read_time = $4, read_time_override = $5,
+			word_count = $6, toc = $7, html = $8, json = $9
+		WHERE id = $10
 	`
 
-	_, err := tx.Exec(
+	_, err = tx.Exec(
 		query,
 		content.Title,
 		content.Description,
 		content.Image,
-		content.ReadTime,
-		content.HTML,
+		readTime,
+		content.ReadTimeOverride,
+		stats.WordCount,
+		stats.TOC,
+		utils.SanitizeHTML(content.HTML), // Saklanan HTML her zaman izin listesinden geçer
 		content.JSON,
 		blogID,
 	)
@@ -0,0 +1,24 @@
+// repositories/image/delete-images-by-url.go
+package ImageRepository
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+)
+
+// DeleteImagesByURL verilen URL'lere sahip resimlerin durumunu 'deleted' olarak günceller
+func (r *Repository) DeleteImagesByURL(ctx context.Context, urls []string) error {
+	if len(urls) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE images
+		SET status = 'deleted', updated_at = NOW()
+		WHERE url = ANY($1) AND status != 'deleted'
+	`
+
+	_, err := r.db.ExecContext(ctx, query, pq.Array(urls))
+	return err
+}
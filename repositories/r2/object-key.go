@@ -0,0 +1,16 @@
+package R2Repository
+
+import (
+	"strings"
+)
+
+// ObjectKeyFromURL genel erişim URL'sinden bucket içindeki nesne anahtarını çıkarır.
+// URL bu bucket'a ait değilse false döner.
+func (r *Repository) ObjectKeyFromURL(url string) (string, bool) {
+	base := strings.TrimSuffix(r.publicURLBase, "/") + "/"
+	if r.publicURLBase == "" || !strings.HasPrefix(url, base) {
+		return "", false
+	}
+
+	return strings.TrimPrefix(url, base), true
+}
@@ -0,0 +1,178 @@
+package TokenRepository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+const apiKeyColumns = `ak.id, ak.user_id, ak.name, ak.prefix, ak.scopes, ak.expires_at, ak.last_used_at, ak.last_used_ip, ak.revoked_at, ak.created_at`
+
+// CreateAPIKey yeni bir API anahtarı kaydeder (sadece hash saklanır)
+func (r *Repository) CreateAPIKey(userID uuid.UUID, name string, prefix string, keyHash string, scopes []string, expiresAt *time.Time) (types.APIKey, error) {
+	defer utils.TimeTrack(time.Now(), "Token -> Create API Key")
+
+	query := `
+		INSERT INTO api_keys AS ak (user_id, name, prefix, key_hash, scopes, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + apiKeyColumns
+
+	key, err := scanAPIKey(r.db.QueryRow(query, userID, name, prefix, keyHash, pq.Array(scopes), expiresAt))
+	if err != nil {
+		return key, fmt.Errorf("error creating api key: %w", err)
+	}
+
+	return key, nil
+}
+
+// SelectAPIKeysByUserID kullanıcının tüm API anahtarlarını (iptal edilenler dahil) listeler
+func (r *Repository) SelectAPIKeysByUserID(userID uuid.UUID) ([]types.APIKey, error) {
+	defer utils.TimeTrack(time.Now(), "Token -> Select API Keys By User ID")
+
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys ak WHERE ak.user_id = $1 ORDER BY ak.created_at DESC`
+
+	rows, err := r.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving api keys: %w", err)
+	}
+	defer rows.Close()
+
+	keys := []types.APIKey{}
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning api key: %w", err)
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, rows.Err()
+}
+
+// CountActiveAPIKeys kullanıcının iptal edilmemiş ve süresi dolmamış anahtar sayısını döndürür
+func (r *Repository) CountActiveAPIKeys(userID uuid.UUID) (int, error) {
+	defer utils.TimeTrack(time.Now(), "Token -> Count Active API Keys")
+
+	var count int
+	query := `SELECT COUNT(*) FROM api_keys WHERE user_id = $1 AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > NOW())`
+	if err := r.db.QueryRow(query, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("error counting api keys: %w", err)
+	}
+
+	return count, nil
+}
+
+// RevokeAPIKey kullanıcıya ait bir API anahtarını iptal eder
+func (r *Repository) RevokeAPIKey(userID uuid.UUID, keyID uuid.UUID) error {
+	defer utils.TimeTrack(time.Now(), "Token -> Revoke API Key")
+
+	result, err := r.db.Exec(`UPDATE api_keys SET revoked_at = NOW() WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL`, keyID, userID)
+	if err != nil {
+		return fmt.Errorf("error revoking api key: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error checking rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("api key not found")
+	}
+
+	return nil
+}
+
+// RevokeAllUserAPIKeys kullanıcının tüm aktif API anahtarlarını iptal eder
+func (r *Repository) RevokeAllUserAPIKeys(userID uuid.UUID) error {
+	defer utils.TimeTrack(time.Now(), "Token -> Revoke All User API Keys")
+
+	_, err := r.db.Exec(`UPDATE api_keys SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL`, userID)
+	if err != nil {
+		return fmt.Errorf("error revoking api keys: %w", err)
+	}
+
+	return nil
+}
+
+// SelectActiveAPIKeyByHash geçerli bir API anahtarını sahibinin güncel kullanıcı bilgileriyle getirir
+func (r *Repository) SelectActiveAPIKeyByHash(keyHash string) (types.APIKeyAuth, error) {
+	defer utils.TimeTrack(time.Now(), "Token -> Select Active API Key By Hash")
+
+	var auth types.APIKeyAuth
+
+	query := `
+		SELECT ` + apiKeyColumns + `,
+			u.id, u.username, u.email, u.role, COALESCE(u.email_verified, FALSE), u.status, u.created_at, COALESCE(u.last_login, u.created_at)
+		FROM api_keys ak
+		JOIN users u ON ak.user_id = u.id
+		WHERE ak.key_hash = $1
+		  AND ak.revoked_at IS NULL
+		  AND (ak.expires_at IS NULL OR ak.expires_at > NOW())
+	`
+
+	var scopes []string
+	user := &auth.User
+	key := &auth.Key
+
+	err := r.db.QueryRow(query, keyHash).Scan(
+		&key.ID, &key.UserID, &key.Name, &key.Prefix, pq.Array(&scopes),
+		&key.ExpiresAt, &key.LastUsedAt, &key.LastUsedIP, &key.RevokedAt, &key.CreatedAt,
+		&user.ID, &user.Username, &user.Email, &user.Role, &user.EmailVerified, &user.Status, &user.CreatedAt, &user.LastLogin,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return auth, fmt.Errorf("api key not found: %w", err)
+		}
+		return auth, fmt.Errorf("error retrieving api key: %w", err)
+	}
+
+	key.Scopes = scopes
+	return auth, nil
+}
+
+// TouchAPIKey son kullanım bilgisini günceller.
+// Her istekte yazma yapmamak için kayıt en fazla API_KEY_LAST_USED_WINDOW aralıkla güncellenir.
+func (r *Repository) TouchAPIKey(keyID uuid.UUID, ipAddress string) error {
+	query := `
+		UPDATE api_keys SET last_used_at = NOW(), last_used_ip = $2
+		WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < NOW() - $3::interval)
+	`
+
+	_, err := r.db.Exec(query, keyID, ipAddress, fmt.Sprintf("%d seconds", int(configs.API_KEY_LAST_USED_WINDOW.Seconds())))
+	if err != nil {
+		return fmt.Errorf("error updating api key last used: %w", err)
+	}
+
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAPIKey(row rowScanner) (types.APIKey, error) {
+	var key types.APIKey
+	var scopes []string
+
+	err := row.Scan(
+		&key.ID, &key.UserID, &key.Name, &key.Prefix, pq.Array(&scopes),
+		&key.ExpiresAt, &key.LastUsedAt, &key.LastUsedIP, &key.RevokedAt, &key.CreatedAt,
+	)
+	if err != nil {
+		return key, err
+	}
+
+	key.Scopes = scopes
+	if key.Scopes == nil {
+		key.Scopes = []string{}
+	}
+
+	return key, nil
+}
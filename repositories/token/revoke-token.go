@@ -1,8 +1,10 @@
 package TokenRepository
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/okanay/backend-blog-guideofdubai/utils"
 )
 
@@ -19,7 +21,7 @@ func (r *Repository) RevokeRefreshToken(token string, reason string) error {
 	return nil
 }
 
-func (r *Repository) RevokeAllUserTokens(userID int64, reason string) error {
+func (r *Repository) RevokeAllUserTokens(userID uuid.UUID, reason string) error {
 	defer utils.TimeTrack(time.Now(), "Token -> Revoke All User Tokens")
 
 	query := `UPDATE refresh_tokens SET is_revoked = TRUE, revoked_reason = $1
@@ -46,3 +48,59 @@ func (r *Repository) RevokeExpiredTokens() error {
 
 	return nil
 }
+
+// RevokeOtherUserTokens kullanıcının mevcut oturumu dışındaki tüm oturumlarını sonlandırır
+func (r *Repository) RevokeOtherUserTokens(userID uuid.UUID, currentToken string, reason string) error {
+	defer utils.TimeTrack(time.Now(), "Token -> Revoke Other User Tokens")
+
+	query := `UPDATE refresh_tokens SET is_revoked = TRUE, revoked_reason = $1
+              WHERE user_id = $2 AND token != $3 AND is_revoked = FALSE`
+
+	_, err := r.db.Exec(query, reason, userID, currentToken)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RevokeUserSession kullanıcıya ait tek bir oturumu (token family'sinin tamamını) sonlandırır
+func (r *Repository) RevokeUserSession(userID uuid.UUID, familyID uuid.UUID, reason string) error {
+	defer utils.TimeTrack(time.Now(), "Token -> Revoke User Session")
+
+	query := `UPDATE refresh_tokens SET is_revoked = TRUE, revoked_reason = $1
+              WHERE family_id = $2 AND user_id = $3 AND is_revoked = FALSE`
+
+	result, err := r.db.Exec(query, reason, familyID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("session not found")
+	}
+
+	return nil
+}
+
+// DeleteStaleTokens saklama süresinden daha önce rotate/iptal edilmiş veya süresi dolmuş tokenları siler.
+// Rotate edilmiş tokenlar saklama süresi boyunca tekrar kullanım tespiti için tutulur.
+func (r *Repository) DeleteStaleTokens(retention time.Duration) (int64, error) {
+	defer utils.TimeTrack(time.Now(), "Token -> Delete Stale Tokens")
+
+	cutoff := time.Now().Add(-retention)
+	query := `DELETE FROM refresh_tokens
+              WHERE (is_revoked = TRUE AND last_used_at < $1) OR expires_at < $1`
+
+	result, err := r.db.Exec(query, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("error deleting stale refresh tokens: %w", err)
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,197 @@
+package TokenRepository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+var (
+	ErrRefreshTokenNotFound = errors.New("refresh token not found")
+	ErrRefreshTokenRevoked  = errors.New("refresh token revoked")
+	ErrRefreshTokenExpired  = errors.New("refresh token expired")
+	ErrRefreshTokenReused   = errors.New("refresh token reuse detected")
+)
+
+// RotateRefreshToken verilen refresh tokenı tek kullanımlık olarak yeni bir token ile değiştirir ve aktif tokenı döndürür.
+//
+//   - Token aktifse: aynı family içinde newToken oluşturulur, eski token rotate edildi olarak işaretlenir.
+//   - Token grace süresi içinde zaten rotate edildiyse (paralel istekler): family'deki aktif token döndürülür.
+//   - Token grace süresi dışında tekrar kullanıldıysa: tüm family iptal edilir, olay kaydedilir ve ErrRefreshTokenReused döner.
+func (r *Repository) RotateRefreshToken(oldToken string, newToken string, ipAddress string, userAgent string, grace time.Duration) (types.RefreshToken, error) {
+	defer utils.TimeTrack(time.Now(), "Token -> Rotate Refresh Token")
+
+	var result types.RefreshToken
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return result, fmt.Errorf("failed to initiate transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// 1. Tokenı kilitle; aynı token ile gelen paralel yenilemeler burada sıraya girer
+	current, err := selectRefreshTokenForUpdate(tx, `token = $1`, oldToken)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrRefreshTokenNotFound
+		}
+		return result, err
+	}
+
+	now := time.Now()
+
+	// 2. Daha önce rotate edilmiş token
+	if current.RotatedAt != nil {
+		if now.Sub(*current.RotatedAt) <= grace {
+			var successor types.RefreshToken
+			successor, err = selectRefreshTokenForUpdate(tx, `family_id = $1 AND is_revoked = FALSE AND rotated_at IS NULL AND expires_at > NOW()`, current.FamilyID)
+			if err == nil {
+				if err = tx.Commit(); err != nil {
+					return result, fmt.Errorf("failed to commit transaction: %w", err)
+				}
+				return successor, nil
+			}
+			if !errors.Is(err, sql.ErrNoRows) {
+				return result, err
+			}
+			// Family zaten iptal edilmiş
+			err = ErrRefreshTokenRevoked
+			return result, err
+		}
+
+		// Grace süresi dışında tekrar kullanım: token çalınmış olabilir, tüm family iptal edilir
+		_, err = tx.Exec(`UPDATE refresh_tokens SET is_revoked = TRUE, revoked_reason = 'Token reuse detected'
+			WHERE family_id = $1 AND is_revoked = FALSE`, current.FamilyID)
+		if err != nil {
+			return result, fmt.Errorf("error revoking token family: %w", err)
+		}
+
+		_, err = tx.Exec(`INSERT INTO refresh_token_reuse_events (user_id, family_id, token_id, ip_address, user_agent)
+			VALUES ($1, $2, $3, $4, $5)`, current.UserID, current.FamilyID, current.ID, ipAddress, userAgent)
+		if err != nil {
+			return result, fmt.Errorf("error recording token reuse event: %w", err)
+		}
+
+		if err = tx.Commit(); err != nil {
+			return result, fmt.Errorf("failed to commit transaction: %w", err)
+		}
+
+		return result, ErrRefreshTokenReused
+	}
+
+	// 3. İptal edilmiş veya süresi dolmuş token
+	if current.IsRevoked {
+		err = ErrRefreshTokenRevoked
+		return result, err
+	}
+
+	if current.ExpiresAt.Before(now) {
+		err = ErrRefreshTokenExpired
+		return result, err
+	}
+
+	// 4. Yeni tokenı aynı family içinde oluştur (oturumun mutlak süresi korunur)
+	rows, err := tx.Query(`
+		INSERT INTO refresh_tokens (
+			user_id, user_email, user_username, token, ip_address, user_agent,
+			expires_at, created_at, last_used_at, family_id, parent_id, session_started_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8, $9, $10, $11)
+		RETURNING *`,
+		current.UserID, current.UserEmail, current.UserUsername, newToken, ipAddress, userAgent,
+		current.ExpiresAt, now, current.FamilyID, current.ID, current.SessionStartedAt,
+	)
+	if err != nil {
+		return result, fmt.Errorf("error creating rotated refresh token: %w", err)
+	}
+
+	if !rows.Next() {
+		rows.Close()
+		err = fmt.Errorf("no rows returned after rotated token insert")
+		return result, err
+	}
+
+	err = utils.ScanStructByDBTags(rows, &result)
+	rows.Close()
+	if err != nil {
+		return result, fmt.Errorf("error scanning rotated refresh token: %w", err)
+	}
+
+	// 5. Eski tokenı rotate edildi olarak işaretle
+	_, err = tx.Exec(`UPDATE refresh_tokens
+		SET is_revoked = TRUE, revoked_reason = 'Rotated', rotated_at = $1, replaced_by = $2, last_used_at = $1
+		WHERE id = $3`, now, result.ID, current.ID)
+	if err != nil {
+		return result, fmt.Errorf("error marking refresh token as rotated: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return result, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return result, nil
+}
+
+// selectRefreshTokenForUpdate verilen koşula uyan en yeni tokenı satır kilidiyle getirir
+func selectRefreshTokenForUpdate(tx *sql.Tx, condition string, arg any) (types.RefreshToken, error) {
+	var token types.RefreshToken
+
+	query := `SELECT * FROM refresh_tokens WHERE ` + condition + ` ORDER BY created_at DESC LIMIT 1 FOR UPDATE`
+	rows, err := tx.Query(query, arg)
+	if err != nil {
+		return token, fmt.Errorf("error retrieving refresh token: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return token, fmt.Errorf("error retrieving refresh token: %w", err)
+		}
+		return token, sql.ErrNoRows
+	}
+
+	if err := utils.ScanStructByDBTags(rows, &token); err != nil {
+		return token, fmt.Errorf("error scanning refresh token: %w", err)
+	}
+
+	return token, nil
+}
+
+// SelectTokenReuseEvents bir kullanıcıya ait token tekrar kullanım olaylarını listeler
+func (r *Repository) SelectTokenReuseEvents(userID uuid.UUID) ([]types.TokenReuseEvent, error) {
+	defer utils.TimeTrack(time.Now(), "Token -> Select Token Reuse Events")
+
+	query := `
+		SELECT id, family_id, token_id, COALESCE(ip_address, ''), COALESCE(user_agent, ''), created_at
+		FROM refresh_token_reuse_events
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+		LIMIT 100
+	`
+
+	rows, err := r.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving token reuse events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []types.TokenReuseEvent{}
+	for rows.Next() {
+		var event types.TokenReuseEvent
+		if err := rows.Scan(&event.ID, &event.FamilyID, &event.TokenID, &event.IPAddress, &event.UserAgent, &event.CreatedAt); err != nil {
+			return nil, fmt.Errorf("error scanning token reuse event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
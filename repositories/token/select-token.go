@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/okanay/backend-blog-guideofdubai/types"
 	"github.com/okanay/backend-blog-guideofdubai/utils"
 )
@@ -33,27 +34,22 @@ func (r *Repository) SelectRefreshTokenByToken(token string) (types.RefreshToken
 	return refreshToken, nil
 }
 
-func (r *Repository) SelectActiveTokensByUserID(userID int64) ([]types.RefreshToken, error) {
+func (r *Repository) SelectActiveTokensByUserID(userID uuid.UUID) ([]types.RefreshToken, error) {
 	defer utils.TimeTrack(time.Now(), "Token -> Select Active Tokens By User ID")
 
-	var tokens []types.RefreshToken
+	tokens := []types.RefreshToken{}
 
-	query := `SELECT * FROM refresh_tokens WHERE user_id = $1 AND is_revoked = FALSE AND expires_at > NOW()`
+	query := `SELECT * FROM refresh_tokens WHERE user_id = $1 AND is_revoked = FALSE AND expires_at > NOW() ORDER BY last_used_at DESC`
 	rows, err := r.db.Query(query, userID)
-	defer rows.Close()
-
 	if err != nil {
 		return tokens, err
 	}
-
-	if !rows.Next() {
-		return tokens, fmt.Errorf("No rows returned after select")
-	}
+	defer rows.Close()
 
 	for rows.Next() {
 		var token types.RefreshToken
 		if err := utils.ScanStructByDBTags(rows, &token); err != nil {
-			continue
+			return tokens, fmt.Errorf("token verileri okunurken hata: %w", err)
 		}
 		tokens = append(tokens, token)
 	}
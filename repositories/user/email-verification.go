@@ -0,0 +1,100 @@
+package UserRepository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// CreateEmailVerificationToken yeni bir e-posta doğrulama tokenı kaydeder (sadece hash saklanır)
+func (r *Repository) CreateEmailVerificationToken(userID uuid.UUID, tokenHash string, expiresAt time.Time) error {
+	defer utils.TimeTrack(time.Now(), "User -> Create Email Verification Token")
+
+	query := `
+		INSERT INTO email_verification_tokens (user_id, token_hash, expires_at)
+		VALUES ($1, $2, $3)
+	`
+
+	_, err := r.db.Exec(query, userID, tokenHash, expiresAt)
+	if err != nil {
+		return fmt.Errorf("error creating email verification token: %w", err)
+	}
+
+	return nil
+}
+
+// SelectEmailVerificationStats yeniden gönderim limiti için son token zamanını ve verilen tarihten beri üretilen token sayısını döndürür
+func (r *Repository) SelectEmailVerificationStats(userID uuid.UUID, since time.Time) (int, *time.Time, error) {
+	defer utils.TimeTrack(time.Now(), "User -> Select Email Verification Stats")
+
+	query := `
+		SELECT
+			COUNT(*) FILTER (WHERE created_at >= $2),
+			MAX(created_at)
+		FROM email_verification_tokens
+		WHERE user_id = $1
+	`
+
+	var count int
+	var lastSentAt sql.NullTime
+	err := r.db.QueryRow(query, userID, since).Scan(&count, &lastSentAt)
+	if err != nil {
+		return 0, nil, fmt.Errorf("error retrieving email verification stats: %w", err)
+	}
+
+	if !lastSentAt.Valid {
+		return count, nil, nil
+	}
+
+	return count, &lastSentAt.Time, nil
+}
+
+// VerifyEmailWithToken geçerli bir token ile kullanıcının e-postasını doğrular ve açık tokenları kapatır
+func (r *Repository) VerifyEmailWithToken(tokenHash string) (uuid.UUID, error) {
+	defer utils.TimeTrack(time.Now(), "User -> Verify Email With Token")
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to initiate transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var userID uuid.UUID
+	query := `
+		SELECT user_id
+		FROM email_verification_tokens
+		WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW()
+		FOR UPDATE
+	`
+	err = tx.QueryRow(query, tokenHash).Scan(&userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return uuid.Nil, fmt.Errorf("invalid or expired verification token: %w", err)
+		}
+		return uuid.Nil, fmt.Errorf("error retrieving verification token: %w", err)
+	}
+
+	_, err = tx.Exec(`UPDATE users SET email_verified = TRUE, updated_at = $1 WHERE id = $2`, time.Now(), userID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("error verifying user email: %w", err)
+	}
+
+	_, err = tx.Exec(`UPDATE email_verification_tokens SET used_at = NOW() WHERE user_id = $1 AND used_at IS NULL`, userID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("error consuming verification tokens: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return userID, nil
+}
@@ -0,0 +1,87 @@
+package UserRepository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// CreatePasswordResetToken yeni bir şifre sıfırlama tokenı kaydeder (sadece hash saklanır)
+func (r *Repository) CreatePasswordResetToken(userID uuid.UUID, tokenHash string, ipAddress string, expiresAt time.Time) error {
+	defer utils.TimeTrack(time.Now(), "User -> Create Password Reset Token")
+
+	query := `
+		INSERT INTO password_reset_tokens (user_id, token_hash, ip_address, expires_at)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	_, err := r.db.Exec(query, userID, tokenHash, ipAddress, expiresAt)
+	if err != nil {
+		return fmt.Errorf("error creating password reset token: %w", err)
+	}
+
+	return nil
+}
+
+// ResetPasswordWithToken geçerli bir token ile şifreyi tek transaction içinde günceller.
+// Token kullanıldı olarak işaretlenir ve kullanıcının diğer açık tokenları da geçersiz kılınır.
+func (r *Repository) ResetPasswordWithToken(tokenHash string, newPassword string) (uuid.UUID, error) {
+	defer utils.TimeTrack(time.Now(), "User -> Reset Password With Token")
+
+	hash, err := utils.EncryptPassword(newPassword)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to initiate transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// 1. Tokenı kilitleyerek doğrula (aynı token ile eşzamanlı iki istek engellenir)
+	var tokenID, userID uuid.UUID
+	query := `
+		SELECT prt.id, prt.user_id
+		FROM password_reset_tokens prt
+		JOIN users u ON prt.user_id = u.id
+		WHERE prt.token_hash = $1
+		  AND prt.used_at IS NULL
+		  AND prt.expires_at > NOW()
+		  AND u.status = 'Active'
+		FOR UPDATE OF prt
+	`
+	err = tx.QueryRow(query, tokenHash).Scan(&tokenID, &userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return uuid.Nil, fmt.Errorf("invalid or expired reset token: %w", err)
+		}
+		return uuid.Nil, fmt.Errorf("error retrieving reset token: %w", err)
+	}
+
+	// 2. Şifreyi güncelle
+	_, err = tx.Exec(`UPDATE users SET hashed_password = $1, updated_at = $2 WHERE id = $3`, hash, time.Now(), userID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("error updating password: %w", err)
+	}
+
+	// 3. Bu token ve kullanıcının diğer açık tokenları artık kullanılamaz
+	_, err = tx.Exec(`UPDATE password_reset_tokens SET used_at = NOW() WHERE user_id = $1 AND used_at IS NULL`, userID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("error consuming reset tokens: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return userID, nil
+}
@@ -0,0 +1,33 @@
+package UserRepository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+func (r *Repository) SelectByEmail(email string) (types.User, error) {
+	defer utils.TimeTrack(time.Now(), "User -> Select User By Email")
+
+	var user types.User
+
+	query := `SELECT * FROM users WHERE email = $1 LIMIT 1`
+	rows, err := r.db.Query(query, email)
+	if err != nil {
+		return user, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return user, fmt.Errorf("No rows returned after select")
+	}
+
+	err = utils.ScanStructByDBTags(rows, &user)
+	if err != nil {
+		return user, err
+	}
+
+	return user, nil
+}
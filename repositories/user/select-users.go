@@ -0,0 +1,87 @@
+package UserRepository
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// SelectUsers admin paneli için kullanıcıları filtreleyerek listeler ve toplam sayıyı döndürür
+func (r *Repository) SelectUsers(options types.UserQueryOptions) ([]types.UserView, int, error) {
+	defer utils.TimeTrack(time.Now(), "User -> Select Users")
+
+	conditions := []string{}
+	params := []any{}
+	paramCounter := 1
+
+	if options.Search != "" {
+		conditions = append(conditions, fmt.Sprintf("(username ILIKE $%d OR email ILIKE $%d)", paramCounter, paramCounter))
+		params = append(params, "%"+options.Search+"%")
+		paramCounter++
+	}
+
+	if options.Role != "" {
+		conditions = append(conditions, fmt.Sprintf("role = $%d", paramCounter))
+		params = append(params, options.Role)
+		paramCounter++
+	}
+
+	if options.Status != "" {
+		conditions = append(conditions, fmt.Sprintf("status = $%d", paramCounter))
+		params = append(params, options.Status)
+		paramCounter++
+	}
+
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int
+	countQuery := "SELECT COUNT(*) FROM users" + whereClause
+	if err := r.db.QueryRow(countQuery, params...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("error counting users: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, username, email, role, COALESCE(email_verified, FALSE), status, created_at, COALESCE(last_login, created_at)
+		FROM users%s
+		ORDER BY created_at DESC
+		LIMIT $%d OFFSET $%d
+	`, whereClause, paramCounter, paramCounter+1)
+	params = append(params, options.Limit, options.Offset)
+
+	rows, err := r.db.Query(query, params...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error retrieving users: %w", err)
+	}
+	defer rows.Close()
+
+	users := []types.UserView{}
+	for rows.Next() {
+		var user types.UserView
+		err := rows.Scan(
+			&user.ID,
+			&user.Username,
+			&user.Email,
+			&user.Role,
+			&user.EmailVerified,
+			&user.Status,
+			&user.CreatedAt,
+			&user.LastLogin,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error scanning user: %w", err)
+		}
+		users = append(users, user)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error processing user rows: %w", err)
+	}
+
+	return users, total, nil
+}
@@ -0,0 +1,299 @@
+package UserRepository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// SelectTwoFactor kullanıcının 2FA kaydını getirir, kayıt yoksa nil döner
+func (r *Repository) SelectTwoFactor(userID uuid.UUID) (*types.UserTwoFactor, error) {
+	defer utils.TimeTrack(time.Now(), "User -> Select Two Factor")
+
+	rows, err := r.db.Query(`SELECT * FROM user_two_factor WHERE user_id = $1`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving two factor: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+
+	var twoFactor types.UserTwoFactor
+	if err := utils.ScanStructByDBTags(rows, &twoFactor); err != nil {
+		return nil, fmt.Errorf("error scanning two factor: %w", err)
+	}
+
+	return &twoFactor, nil
+}
+
+// UpsertPendingTwoFactor onaylanmamış bir 2FA kurulumu başlatır veya mevcut onaylanmamış kurulumun secret'ını yeniler.
+// 2FA zaten etkinse kayıt değişmez ve hata döner.
+func (r *Repository) UpsertPendingTwoFactor(userID uuid.UUID, secret string) error {
+	defer utils.TimeTrack(time.Now(), "User -> Upsert Pending Two Factor")
+
+	query := `
+		INSERT INTO user_two_factor (user_id, secret)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET secret = EXCLUDED.secret, last_used_step = 0, updated_at = NOW()
+		WHERE user_two_factor.enabled = FALSE
+	`
+
+	result, err := r.db.Exec(query, userID, secret)
+	if err != nil {
+		return fmt.Errorf("error creating two factor setup: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error checking rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("two factor already enabled")
+	}
+
+	return nil
+}
+
+// EnableTwoFactor kurulumu onaylar ve kurtarma kodlarını aynı transaction içinde kaydeder
+func (r *Repository) EnableTwoFactor(userID uuid.UUID, step int64, recoveryCodeHashes []string) error {
+	defer utils.TimeTrack(time.Now(), "User -> Enable Two Factor")
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to initiate transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	result, err := tx.Exec(`
+		UPDATE user_two_factor
+		SET enabled = TRUE, confirmed_at = NOW(), last_used_step = $2, updated_at = NOW()
+		WHERE user_id = $1 AND enabled = FALSE`, userID, step)
+	if err != nil {
+		return fmt.Errorf("error enabling two factor: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error checking rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		err = fmt.Errorf("two factor setup not found")
+		return err
+	}
+
+	if err = replaceRecoveryCodes(tx, userID, recoveryCodeHashes); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DisableTwoFactor kullanıcının 2FA kaydını ve kurtarma kodlarını siler
+func (r *Repository) DisableTwoFactor(userID uuid.UUID) error {
+	defer utils.TimeTrack(time.Now(), "User -> Disable Two Factor")
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to initiate transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if _, err = tx.Exec(`DELETE FROM user_recovery_codes WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("error deleting recovery codes: %w", err)
+	}
+
+	if _, err = tx.Exec(`DELETE FROM user_two_factor WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("error deleting two factor: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ConsumeTOTPStep kodun zaman adımını kullanıldı olarak işaretler.
+// Aynı veya daha eski bir adım tekrar gönderilirse false döner (replay koruması).
+func (r *Repository) ConsumeTOTPStep(userID uuid.UUID, step int64) (bool, error) {
+	defer utils.TimeTrack(time.Now(), "User -> Consume TOTP Step")
+
+	result, err := r.db.Exec(`
+		UPDATE user_two_factor SET last_used_step = $2, updated_at = NOW()
+		WHERE user_id = $1 AND last_used_step < $2`, userID, step)
+	if err != nil {
+		return false, fmt.Errorf("error consuming totp step: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error checking rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// ConsumeRecoveryCode kullanılmamış bir kurtarma kodunu tek seferlik olarak harcar
+func (r *Repository) ConsumeRecoveryCode(userID uuid.UUID, codeHash string) (bool, error) {
+	defer utils.TimeTrack(time.Now(), "User -> Consume Recovery Code")
+
+	result, err := r.db.Exec(`
+		UPDATE user_recovery_codes SET used_at = NOW()
+		WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL`, userID, codeHash)
+	if err != nil {
+		return false, fmt.Errorf("error consuming recovery code: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error checking rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// ReplaceRecoveryCodes kullanıcının tüm kurtarma kodlarını yenileriyle değiştirir
+func (r *Repository) ReplaceRecoveryCodes(userID uuid.UUID, recoveryCodeHashes []string) error {
+	defer utils.TimeTrack(time.Now(), "User -> Replace Recovery Codes")
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to initiate transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err = replaceRecoveryCodes(tx, userID, recoveryCodeHashes); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// CountRemainingRecoveryCodes kullanılmamış kurtarma kodu sayısını döndürür
+func (r *Repository) CountRemainingRecoveryCodes(userID uuid.UUID) (int, error) {
+	defer utils.TimeTrack(time.Now(), "User -> Count Remaining Recovery Codes")
+
+	var count int
+	err := r.db.QueryRow(`SELECT COUNT(*) FROM user_recovery_codes WHERE user_id = $1 AND used_at IS NULL`, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("error counting recovery codes: %w", err)
+	}
+
+	return count, nil
+}
+
+// SelectTwoFactorPolicies tüm rollerin 2FA politikalarını getirir
+func (r *Repository) SelectTwoFactorPolicies() ([]types.TwoFactorPolicy, error) {
+	defer utils.TimeTrack(time.Now(), "User -> Select Two Factor Policies")
+
+	rows, err := r.db.Query(`SELECT role, required, updated_by, updated_at FROM two_factor_policies ORDER BY role`)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving two factor policies: %w", err)
+	}
+	defer rows.Close()
+
+	policies := []types.TwoFactorPolicy{}
+	for rows.Next() {
+		var policy types.TwoFactorPolicy
+		var updatedBy uuid.NullUUID
+
+		if err := rows.Scan(&policy.Role, &policy.Required, &updatedBy, &policy.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("error scanning two factor policy: %w", err)
+		}
+		if updatedBy.Valid {
+			policy.UpdatedBy = &updatedBy.UUID
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return policies, rows.Err()
+}
+
+// IsTwoFactorRequired verilen rol için 2FA zorunlu mu kontrol eder
+func (r *Repository) IsTwoFactorRequired(role types.Role) (bool, error) {
+	defer utils.TimeTrack(time.Now(), "User -> Is Two Factor Required")
+
+	var required bool
+	err := r.db.QueryRow(`SELECT required FROM two_factor_policies WHERE role = $1`, role).Scan(&required)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, fmt.Errorf("error retrieving two factor policy: %w", err)
+	}
+
+	return required, nil
+}
+
+// UpdateTwoFactorPolicy bir rolün 2FA zorunluluğunu günceller
+func (r *Repository) UpdateTwoFactorPolicy(role types.Role, required bool, updatedBy uuid.UUID) (types.TwoFactorPolicy, error) {
+	defer utils.TimeTrack(time.Now(), "User -> Update Two Factor Policy")
+
+	var policy types.TwoFactorPolicy
+	var updatedByID uuid.NullUUID
+
+	query := `
+		INSERT INTO two_factor_policies (role, required, updated_by, updated_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (role) DO UPDATE
+		SET required = EXCLUDED.required, updated_by = EXCLUDED.updated_by, updated_at = NOW()
+		RETURNING role, required, updated_by, updated_at
+	`
+
+	err := r.db.QueryRow(query, role, required, updatedBy).Scan(&policy.Role, &policy.Required, &updatedByID, &policy.UpdatedAt)
+	if err != nil {
+		return policy, fmt.Errorf("error updating two factor policy: %w", err)
+	}
+	if updatedByID.Valid {
+		policy.UpdatedBy = &updatedByID.UUID
+	}
+
+	return policy, nil
+}
+
+func replaceRecoveryCodes(tx *sql.Tx, userID uuid.UUID, recoveryCodeHashes []string) error {
+	if _, err := tx.Exec(`DELETE FROM user_recovery_codes WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("error deleting recovery codes: %w", err)
+	}
+
+	for _, hash := range recoveryCodeHashes {
+		if _, err := tx.Exec(`INSERT INTO user_recovery_codes (user_id, code_hash) VALUES ($1, $2)`, userID, hash); err != nil {
+			return fmt.Errorf("error creating recovery code: %w", err)
+		}
+	}
+
+	return nil
+}
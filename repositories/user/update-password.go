@@ -14,7 +14,7 @@ func (r *Repository) UpdatePassword(email string, password string) error {
 		return err
 	}
 
-	query := `UPDATE users SET password=$1, updated_at=$2 WHERE email=$3`
+	query := `UPDATE users SET hashed_password=$1, updated_at=$2 WHERE email=$3`
 
 	_, err = r.db.Exec(query, hash, time.Now(), email)
 	if err != nil {
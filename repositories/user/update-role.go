@@ -0,0 +1,32 @@
+package UserRepository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+func (r *Repository) UpdateRole(id uuid.UUID, role types.Role) error {
+	defer utils.TimeTrack(time.Now(), "User -> Update Role")
+
+	query := `UPDATE users SET role=$1, updated_at=$2 WHERE id=$3 AND status != 'Deleted'`
+
+	result, err := r.db.Exec(query, role, time.Now(), id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("user not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package UserRepository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// UpdateStatus kullanıcının durumunu değiştirir.
+// deleted_at alanı ve refresh token iptalleri veritabanı trigger'ları tarafından yönetilir.
+func (r *Repository) UpdateStatus(id uuid.UUID, status types.UserStatus) error {
+	defer utils.TimeTrack(time.Now(), "User -> Update Status")
+
+	query := `UPDATE users SET status=$1, updated_at=$2 WHERE id=$3`
+
+	result, err := r.db.Exec(query, status, time.Now(), id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("user not found")
+	}
+
+	return nil
+}
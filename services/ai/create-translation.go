@@ -0,0 +1,275 @@
+package AIService
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+	"github.com/sashabaranov/go-openai"
+)
+
+// CreateTranslations kaynak blogu hedef dillere çevirip aynı çeviri grubunda taslak bloglar oluşturur.
+// Diller sırayla işlenir; bir dilin başarısız olması diğerlerini durdurmaz.
+// onProgress her dil başlarken ve bittiğinde çağrılır (nil olabilir).
+func (s *AIService) CreateTranslations(
+	ctx context.Context,
+	source *types.BlogPostView,
+	targetLanguages []string,
+	userID uuid.UUID,
+	onProgress func(types.TranslationProgress),
+) (*types.TranslationReport, []*types.BlogPostView) {
+	languages := normalizeTargetLanguages(targetLanguages)
+
+	report := &types.TranslationReport{
+		SourceID:  source.ID,
+		GroupID:   source.GroupID,
+		Languages: []types.TranslationLanguageResult{},
+	}
+	created := []*types.BlogPostView{}
+
+	// Grupta zaten bulunan diller atlanır
+	existing := map[string]bool{source.Language: true}
+	if members, err := s.BlogRepo.SelectBlogGroup(source.GroupID); err == nil {
+		for _, member := range members {
+			existing[member.Language] = true
+		}
+	}
+
+	publish := func(result types.TranslationLanguageResult, completed int) {
+		if onProgress != nil {
+			onProgress(types.TranslationProgress{
+				Completed: completed,
+				Total:     len(languages),
+				Result:    result,
+			})
+		}
+	}
+
+	for i, language := range languages {
+		result := types.TranslationLanguageResult{Language: language}
+
+		if existing[language] {
+			result.Status = types.TranslationStatusSkipped
+			result.Error = "group_language_exists"
+			result.Message = "Bu çeviri grubunda bu dilde bir blog yazısı zaten var."
+		} else if err := ctx.Err(); err != nil {
+			result.Status = types.TranslationStatusFailed
+			result.Error = "translation_cancelled"
+			result.Message = "Çeviri işlemi iptal edildi."
+		} else {
+			publish(types.TranslationLanguageResult{Language: language, Status: types.TranslationStatusStarted}, i)
+
+			blog, err := s.createTranslation(ctx, source, language, userID, &result)
+			if err != nil {
+				result.Status = types.TranslationStatusFailed
+				result.Error = "translation_failed"
+				result.Message = "Çeviri oluşturulurken bir hata oluştu: " + err.Error()
+			} else {
+				result.Status = types.TranslationStatusCreated
+				result.BlogID = blog.ID
+				result.Slug = blog.Slug
+				existing[language] = true
+				created = append(created, blog)
+			}
+			result.Cost = utils.CalculateAICostWithOutput(result.InputTokens, result.OutputTokens)
+		}
+
+		switch result.Status {
+		case types.TranslationStatusCreated:
+			report.Created++
+		case types.TranslationStatusSkipped:
+			report.Skipped++
+		default:
+			report.Failed++
+		}
+		report.InputTokens += result.InputTokens
+		report.OutputTokens += result.OutputTokens
+		report.Languages = append(report.Languages, result)
+
+		publish(result, i+1)
+	}
+
+	report.Cost = utils.CalculateAICostWithOutput(report.InputTokens, report.OutputTokens)
+	return report, created
+}
+
+// createTranslation tek bir dil için içeriği çevirir ve taslak blogu oluşturur.
+// Token kullanımı hata durumunda da result üzerine yazılır.
+func (s *AIService) createTranslation(
+	ctx context.Context,
+	source *types.BlogPostView,
+	language string,
+	userID uuid.UUID,
+	result *types.TranslationLanguageResult,
+) (*types.BlogPostView, error) {
+	translatedJSON, inTok, outTok, err := s.TranslateBlogPostJSON(ctx, source.Content.JSON, source.Language, language)
+	result.InputTokens += inTok
+	result.OutputTokens += outTok
+	if err != nil {
+		return nil, fmt.Errorf("content translation failed: %w", err)
+	}
+
+	fields, inTok, outTok, err := s.translateBlogFields(ctx, source, language)
+	result.InputTokens += inTok
+	result.OutputTokens += outTok
+	if err != nil {
+		return nil, fmt.Errorf("field translation failed: %w", err)
+	}
+
+	html, err := utils.RenderTipTapHTML(translatedJSON)
+	if err != nil {
+		return nil, fmt.Errorf("html render failed: %w", err)
+	}
+
+	slug := sanitizeSlug(fields.Slug)
+	if slug == "" {
+		slug = source.Slug + "-" + sanitizeSlug(language)
+	}
+	slug, err = s.BlogRepo.SelectAvailableSlug(slug, language)
+	if err != nil {
+		return nil, err
+	}
+
+	input := types.BlogPostCreateInput{
+		GroupID:  source.GroupID,
+		Slug:     slug,
+		Language: language,
+		Status:   types.BlogStatusDraft,
+		Metadata: types.MetadataInput{
+			Title:       fields.MetadataTitle,
+			Description: fields.MetadataDescription,
+			Image:       source.Metadata.Image,
+		},
+		Content: types.ContentInput{
+			Title:       fields.ContentTitle,
+			Description: fields.ContentDescription,
+			Image:       source.Content.Image,
+			HTML:        html,
+			JSON:        translatedJSON,
+		},
+		Categories: make([]string, 0, len(source.Categories)),
+		Tags:       make([]string, 0, len(source.Tags)),
+	}
+	for _, category := range source.Categories {
+		input.Categories = append(input.Categories, category.Name)
+	}
+	for _, tag := range source.Tags {
+		input.Tags = append(input.Tags, tag.Name)
+	}
+
+	// Çeviri, blog oluşturma isteğiyle aynı içerik kurallarından geçmelidir
+	if err := utils.ValidateInput(input); err != nil {
+		return nil, fmt.Errorf("translated content failed validation: %w", err)
+	}
+
+	blog, err := s.BlogRepo.CreateBlogPost(input, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error creating translated blog: %w", err)
+	}
+
+	return blog, nil
+}
+
+// translateBlogFields başlık, açıklama ve slug alanlarını tek istekte çevirir
+func (s *AIService) translateBlogFields(
+	ctx context.Context,
+	source *types.BlogPostView,
+	language string,
+) (*types.TranslatedBlogFields, int, int, error) {
+	schema, err := types.GetTranslatedBlogFieldsSchema()
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	input, _ := json.MarshalIndent(types.TranslatedBlogFields{
+		ContentTitle:        source.Content.Title,
+		ContentDescription:  source.Content.Description,
+		MetadataTitle:       source.Metadata.Title,
+		MetadataDescription: source.Metadata.Description,
+		Slug:                source.Slug,
+	}, "", "  ")
+
+	resp, err := s.AIRepo.Client().CreateChatCompletion(
+		ctx,
+		openai.ChatCompletionRequest{
+			Model: "gpt-4.1-nano",
+			Messages: []openai.ChatCompletionMessage{
+				{
+					Role: openai.ChatMessageRoleSystem,
+					Content: `You are a professional translator localizing blog posts.
+Translate every field of the given JSON object. Preserve symbols such as &, -, +, @ and / exactly.
+The "slug" must be a natural, SEO-friendly URL slug in the target language, written with lowercase
+ASCII letters, digits and hyphens only (transliterate non-Latin scripts).`,
+				},
+				{
+					Role:    openai.ChatMessageRoleUser,
+					Content: fmt.Sprintf("Translate from %s to %s:\n\n%s", source.Language, language, string(input)),
+				},
+			},
+			Temperature: 0.1,
+			ResponseFormat: &openai.ChatCompletionResponseFormat{
+				Type: openai.ChatCompletionResponseFormatTypeJSONSchema,
+				JSONSchema: &openai.ChatCompletionResponseFormatJSONSchema{
+					Name:        "TranslatedBlogFields",
+					Description: "Translated titles, descriptions and localized slug of a blog post",
+					Schema:      json.RawMessage(schema),
+					Strict:      true,
+				},
+			},
+		},
+	)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("OpenAI API error: %w", err)
+	}
+	if len(resp.Choices) == 0 {
+		return nil, resp.Usage.PromptTokens, resp.Usage.CompletionTokens, fmt.Errorf("empty response from OpenAI API")
+	}
+
+	var fields types.TranslatedBlogFields
+	content := strings.TrimSpace(resp.Choices[0].Message.Content)
+	if err := json.Unmarshal([]byte(content), &fields); err != nil {
+		return nil, resp.Usage.PromptTokens, resp.Usage.CompletionTokens, fmt.Errorf("JSON parse error: %w", err)
+	}
+
+	return &fields, resp.Usage.PromptTokens, resp.Usage.CompletionTokens, nil
+}
+
+// normalizeTargetLanguages dil kodlarını küçük harfe çevirir, boş ve tekrar edenleri atar
+func normalizeTargetLanguages(languages []string) []string {
+	seen := make(map[string]bool, len(languages))
+	normalized := make([]string, 0, len(languages))
+
+	for _, language := range languages {
+		language = strings.ToLower(strings.TrimSpace(language))
+		if language == "" || seen[language] {
+			continue
+		}
+		seen[language] = true
+		normalized = append(normalized, language)
+	}
+
+	return normalized
+}
+
+// sanitizeSlug slug'ı yalnızca a-z, 0-9 ve tek tirelerden oluşacak şekilde temizler
+func sanitizeSlug(value string) string {
+	var sb strings.Builder
+	lastHyphen := true
+
+	for _, r := range strings.ToLower(value) {
+		switch {
+		case (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9'):
+			sb.WriteRune(r)
+			lastHyphen = false
+		case !lastHyphen:
+			sb.WriteRune('-')
+			lastHyphen = true
+		}
+	}
+
+	return strings.TrimSuffix(sb.String(), "-")
+}
@@ -79,6 +79,8 @@ func (s *AIService) TranslateBlogPostJSON(
 	var wg sync.WaitGroup
 	errs := make([]error, len(batches))
 	results := make([][]TextItemTranslation, len(batches))
+	inputTokensPerBatch := make([]int, len(batches))
+	outputTokensPerBatch := make([]int, len(batches))
 
 	for i, batch := range batches {
 		wg.Add(1)
@@ -90,9 +92,10 @@ func (s *AIService) TranslateBlogPostJSON(
 				return
 			}
 
+			// Her goroutine yalnızca kendi indeksine yazar; toplamlar Wait sonrası hesaplanır
 			results[i] = trans
-			totalInputTokens += inTok
-			totalOutputTokens += outTok
+			inputTokensPerBatch[i] = inTok
+			outputTokensPerBatch[i] = outTok
 		}(i, batch)
 	}
 	wg.Wait()
@@ -104,9 +107,11 @@ func (s *AIService) TranslateBlogPostJSON(
 		}
 	}
 
-	// Tüm çevirileri birleştir
-	for _, batchTrans := range results {
+	// Tüm çevirileri ve token kullanımlarını birleştir
+	for i, batchTrans := range results {
 		allTranslations = append(allTranslations, batchTrans...)
+		totalInputTokens += inputTokensPerBatch[i]
+		totalOutputTokens += outputTokensPerBatch[i]
 	}
 
 	// Çevirileri orijinal JSON'a yerleştir
@@ -0,0 +1,163 @@
+package AnalyticsService
+
+import (
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	AnalyticsRepository "github.com/okanay/backend-blog-guideofdubai/repositories/analytics"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+)
+
+// AnalyticsService ziyaretçi aramalarını ve arama sonucu tıklamalarını kaydeder.
+// Kayıtlar tamponlu bir kanala bırakılır ve arka planda toplu olarak yazılır; istekler veritabanını beklemez.
+// Tampon doluysa kayıt atılır ve loglanır.
+type AnalyticsService struct {
+	Repo     *AnalyticsRepository.Repository
+	records  chan searchRecord
+	interval time.Duration
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// searchRecord kanaldaki tek bir kayıt; arama ve tıklamalar aynı kanaldan geçerek sıralarını korur
+type searchRecord struct {
+	search *types.SearchQueryEvent
+	click  *types.SearchClickEvent
+}
+
+func NewAnalyticsService(repo *AnalyticsRepository.Repository) *AnalyticsService {
+	return &AnalyticsService{
+		Repo:     repo,
+		records:  make(chan searchRecord, configs.SEARCH_ANALYTICS_BUFFER_SIZE),
+		interval: configs.SEARCH_ANALYTICS_FLUSH_INTERVAL,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start kayıtları toplu yazan arka plan döngüsünü başlatır
+func (s *AnalyticsService) Start() {
+	go func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		batch := make([]searchRecord, 0, configs.SEARCH_ANALYTICS_BATCH_SIZE)
+		for {
+			select {
+			case record := <-s.records:
+				batch = append(batch, record)
+				if len(batch) >= configs.SEARCH_ANALYTICS_BATCH_SIZE {
+					batch = s.flush(batch)
+				}
+			case <-ticker.C:
+				batch = s.flush(batch)
+			case <-s.stop:
+				// Kapanmadan önce kanalda kalan kayıtlar da yazılır
+				for {
+					select {
+					case record := <-s.records:
+						batch = append(batch, record)
+					default:
+						s.flush(batch)
+						return
+					}
+				}
+			}
+		}
+	}()
+}
+
+// Stop döngüyü durdurur ve bekleyen kayıtlar yazılana kadar bekler
+func (s *AnalyticsService) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stop)
+		<-s.done
+	})
+}
+
+// RecordSearch bir aramayı kuyruğa ekler ve tıklama takibi için arama ID'sini döndürür.
+// Sorgu normalize edildikten sonra boşsa kayıt yapılmaz ve uuid.Nil döner.
+func (s *AnalyticsService) RecordSearch(query string, language string, source types.SearchQuerySource, resultCount int) uuid.UUID {
+	normalized := NormalizeSearchQuery(query)
+	if normalized == "" {
+		return uuid.Nil
+	}
+
+	event := &types.SearchQueryEvent{
+		ID:          uuid.New(),
+		Query:       normalized,
+		Language:    strings.ToLower(strings.TrimSpace(language)),
+		Source:      source,
+		ResultCount: resultCount,
+		CreatedAt:   time.Now(),
+	}
+
+	if !s.enqueue(searchRecord{search: event}) {
+		return uuid.Nil
+	}
+
+	return event.ID
+}
+
+// RecordClick arama sonucundaki bir bloga tıklanmasını kuyruğa ekler
+func (s *AnalyticsService) RecordClick(searchID uuid.UUID, blogID uuid.UUID) bool {
+	return s.enqueue(searchRecord{click: &types.SearchClickEvent{
+		SearchID:  searchID,
+		BlogID:    blogID,
+		ClickedAt: time.Now(),
+	}})
+}
+
+func (s *AnalyticsService) enqueue(record searchRecord) bool {
+	select {
+	case s.records <- record:
+		return true
+	default:
+		log.Printf("[ANALYTICS]: Arama kuyruğu dolu, kayıt atlandı")
+		return false
+	}
+}
+
+// flush batch'i yazar ve yeniden kullanılmak üzere boşaltılmış slice'ı döndürür.
+// Yazma hatası istekleri etkilemez, yalnızca loglanır.
+func (s *AnalyticsService) flush(batch []searchRecord) []searchRecord {
+	if len(batch) == 0 {
+		return batch
+	}
+
+	events := []types.SearchQueryEvent{}
+	clicks := []types.SearchClickEvent{}
+	for _, record := range batch {
+		if record.search != nil {
+			events = append(events, *record.search)
+		}
+		if record.click != nil {
+			clicks = append(clicks, *record.click)
+		}
+	}
+
+	if err := s.Repo.SaveSearchBatch(events, clicks); err != nil {
+		log.Printf("[ANALYTICS]: %d arama, %d tıklama kaydedilemedi: %v", len(events), len(clicks), err)
+	}
+
+	return batch[:0]
+}
+
+// NormalizeSearchQuery sorguyu küçük harfe çevirir, boşlukları tekilleştirir ve uzunluğunu sınırlar
+func NormalizeSearchQuery(query string) string {
+	normalized := strings.Join(strings.Fields(strings.ToLower(query)), " ")
+
+	runes := []rune(normalized)
+	if len(runes) > configs.SEARCH_ANALYTICS_QUERY_MAX_RUNES {
+		normalized = strings.TrimSpace(string(runes[:configs.SEARCH_ANALYTICS_QUERY_MAX_RUNES]))
+	}
+
+	return normalized
+}
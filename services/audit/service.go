@@ -0,0 +1,80 @@
+package AuditService
+
+import (
+	"encoding/json"
+	"log"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	AuditRepository "github.com/okanay/backend-blog-guideofdubai/repositories/audit"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+// AuditService içerik ve yönetim işlemlerini kimin, ne zaman, nereden yaptığıyla birlikte kaydeder.
+// Kayıt hatası asıl işlemi başarısız saymaz, yalnızca loglanır.
+type AuditService struct {
+	AuditRepo *AuditRepository.Repository
+}
+
+func NewAuditService(auditRepo *AuditRepository.Repository) *AuditService {
+	return &AuditService{
+		AuditRepo: auditRepo,
+	}
+}
+
+// Record istek bağlamındaki kullanıcı, IP ve user agent bilgisiyle bir audit kaydı oluşturur.
+// before/after nil verilirse ilgili snapshot boş bırakılır.
+func (s *AuditService) Record(c *gin.Context, action types.AuditAction, targetType types.AuditTargetType, targetID string, before any, after any) {
+	input := types.AuditEventInput{
+		ActorUsername: c.GetString("username"),
+		Action:        action,
+		TargetType:    targetType,
+		TargetID:      targetID,
+		Before:        snapshot(before),
+		After:         snapshot(after),
+		IPAddress:     utils.GetTrueClientIP(c),
+		UserAgent:     c.Request.UserAgent(),
+		AuthMethod:    c.GetString("auth_method"),
+	}
+
+	if value, exists := c.Get("user_id"); exists {
+		if actorID, ok := value.(uuid.UUID); ok {
+			input.ActorID = &actorID
+		}
+	}
+
+	s.create(input)
+}
+
+// RecordSystem kullanıcı isteği dışında (ör. zamanlayıcı) gerçekleşen işlemleri kaydeder
+func (s *AuditService) RecordSystem(action types.AuditAction, targetType types.AuditTargetType, targetID string, before any, after any) {
+	s.create(types.AuditEventInput{
+		ActorUsername: "system",
+		Action:        action,
+		TargetType:    targetType,
+		TargetID:      targetID,
+		Before:        snapshot(before),
+		After:         snapshot(after),
+		AuthMethod:    "system",
+	})
+}
+
+func (s *AuditService) create(input types.AuditEventInput) {
+	if err := s.AuditRepo.CreateAuditEvent(input); err != nil {
+		log.Printf("[AUDIT]: %s kaydı oluşturulamadı: %v", input.Action, err)
+	}
+}
+
+func snapshot(value any) json.RawMessage {
+	if value == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil || string(data) == "null" {
+		return nil
+	}
+
+	return data
+}
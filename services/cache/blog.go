@@ -6,6 +6,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -129,42 +130,27 @@ func (s *BlogCacheService) SaveBlogAndAlternativesBySlug(slug string, mainBlog *
 	return nil
 }
 
-// GetBlogCards blog kartlarını cache'den getirir (revize edilmiş)
-func (s *BlogCacheService) GetBlogCards(queryOptions types.BlogCardQueryOptions) ([]types.BlogPostCardView, int, bool) {
+// GetBlogCards blog kartlarını, toplam sayıyı ve cursor'ları cache'den getirir
+func (s *BlogCacheService) GetBlogCards(queryOptions types.BlogCardQueryOptions) (*types.BlogCardPage, bool) {
 	cacheKey := s.GenerateBlogCardsCacheKey(queryOptions)
 	cachedData, exists := s.cache.Get(cacheKey)
 	if !exists {
-		return nil, 0, false
-	}
-
-	// Cache'te hem blogları hem de total sayıyı tutan yapı
-	type cachedBlogResult struct {
-		Blogs []types.BlogPostCardView `json:"blogs"`
-		Total int                      `json:"total"`
+		return nil, false
 	}
 
-	var result cachedBlogResult
-	if err := json.Unmarshal(cachedData, &result); err != nil {
-		return nil, 0, false
+	var page types.BlogCardPage
+	if err := json.Unmarshal(cachedData, &page); err != nil {
+		return nil, false
 	}
 
-	return result.Blogs, result.Total, true
+	return &page, true
 }
 
-// SaveBlogCards blog kartlarını cache'e kaydeder (revize edilmiş)
-func (s *BlogCacheService) SaveBlogCards(queryOptions types.BlogCardQueryOptions, blogs []types.BlogPostCardView, total int) error {
+// SaveBlogCards blog kartlarını, toplam sayıyı ve cursor'ları cache'e kaydeder
+func (s *BlogCacheService) SaveBlogCards(queryOptions types.BlogCardQueryOptions, page *types.BlogCardPage) error {
 	cacheKey := s.GenerateBlogCardsCacheKey(queryOptions)
 
-	// Hem blogları hem de total sayıyı saklayan yapı
-	data := struct {
-		Blogs []types.BlogPostCardView `json:"blogs"`
-		Total int                      `json:"total"`
-	}{
-		Blogs: blogs,
-		Total: total,
-	}
-
-	jsonData, err := json.Marshal(data)
+	jsonData, err := json.Marshal(page)
 	if err != nil {
 		return err
 	}
@@ -202,6 +188,33 @@ func (s *BlogCacheService) GenerateBlogCardsCacheKey(options types.BlogCardQuery
 	h.Write([]byte(options.SortBy))
 	h.Write([]byte(string(options.SortDirection)))
 
+	// Çoklu değerli filtreler sıradan ve tekrardan bağımsızdır; aynı küme aynı key'i üretir
+	for _, filter := range []struct {
+		name   string
+		values []string
+	}{
+		{"categories", options.CategoryValues},
+		{"tags", options.TagValues},
+		{"exclude-categories", options.ExcludeCategoryValues},
+	} {
+		if len(filter.values) > 0 {
+			values := slices.Clone(filter.values)
+			slices.Sort(values)
+			values = slices.Compact(values)
+			fmt.Fprintf(h, "%s:%s|", filter.name, strings.Join(values, ","))
+		}
+	}
+
+	if options.AuthorID != nil {
+		fmt.Fprintf(h, "author:%s|", options.AuthorID.String())
+	}
+	if options.MinReadTime != nil {
+		fmt.Fprintf(h, "min-read-time:%d|", *options.MinReadTime)
+	}
+	if options.MaxReadTime != nil {
+		fmt.Fprintf(h, "max-read-time:%d|", *options.MaxReadTime)
+	}
+
 	// Boolean değerleri ekle
 	if options.Featured {
 		h.Write([]byte("featured:true"))
@@ -211,6 +224,11 @@ func (s *BlogCacheService) GenerateBlogCardsCacheKey(options types.BlogCardQuery
 	h.Write([]byte(strconv.Itoa(options.Limit)))
 	h.Write([]byte(strconv.Itoa(options.Offset)))
 
+	// Cursor konumu
+	if options.Cursor != nil {
+		fmt.Fprintf(h, "cursor:%s:%s:%t", options.Cursor.Key, options.Cursor.ID, options.Cursor.Backward)
+	}
+
 	// Tarih filtreleri varsa ekle
 	if options.StartDate != nil {
 		h.Write([]byte(options.StartDate.Format(time.RFC3339)))
@@ -227,6 +245,40 @@ func (s *BlogCacheService) GenerateBlogCardsCacheKey(options types.BlogCardQuery
 	return fmt.Sprintf("%s:%s", prefix, hashBase64)
 }
 
+// GetBlogSearch arama sonuçlarını cache'den getirir
+func (s *BlogCacheService) GetBlogSearch(options types.BlogSearchOptions) (*types.BlogSearchPage, bool) {
+	cachedData, exists := s.cache.Get(s.generateBlogSearchCacheKey(options))
+	if !exists {
+		return nil, false
+	}
+
+	var page types.BlogSearchPage
+	if err := json.Unmarshal(cachedData, &page); err != nil {
+		return nil, false
+	}
+
+	return &page, true
+}
+
+// SaveBlogSearch arama sonuçlarını cache'e kaydeder
+func (s *BlogCacheService) SaveBlogSearch(options types.BlogSearchOptions, page *types.BlogSearchPage) error {
+	jsonData, err := json.Marshal(page)
+	if err != nil {
+		return err
+	}
+
+	s.cache.Set(s.generateBlogSearchCacheKey(options), jsonData)
+	return nil
+}
+
+func (s *BlogCacheService) generateBlogSearchCacheKey(options types.BlogSearchOptions) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s|%s|%s|%s|%d|%d",
+		strings.ToLower(strings.TrimSpace(options.Query)), options.Language, options.Category, options.Tag, options.Limit, options.Offset)
+
+	return "blog_search:" + base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
 // GetFeaturedPostsByLanguage belirli bir dil için featured postları cache'den getirir
 func (s *BlogCacheService) GetFeaturedPostsByLanguage(language string) ([]types.BlogPostCardView, bool) {
 	cacheKey := fmt.Sprintf("featured_posts_%s", language)
@@ -329,9 +381,9 @@ func (s *BlogCacheService) SaveRecentPosts(blogs []types.BlogPostCardView) error
 	return nil
 }
 
-// InvalidateAllBlogs tüm blog cache'lerini temizler
+// InvalidateAllBlogs tüm blog cache'lerini temizler (rate limit ve oturum kayıtları korunur)
 func (s *BlogCacheService) InvalidateAllBlogs() {
-	s.cache.Clear()
+	s.cache.ClearExceptPrefixes(ProtectedPrefixes)
 }
 
 // InvalidateBlogByID belirli bir blog ID'ye ait cache'i temizler
@@ -340,6 +392,46 @@ func (s *BlogCacheService) InvalidateBlogByID(blogID uuid.UUID) {
 	s.cache.Delete(cacheKey)
 }
 
+// InvalidateBlogBySlug slug ve grup bazlı blog cache'lerini temizler.
+// Alternatif diller de slug cache'inde tutulduğu için tüm slug cache'leri temizlenir.
+func (s *BlogCacheService) InvalidateBlogBySlug(groupID string) {
+	s.cache.ClearPrefix("blog_slug:")
+	s.cache.Delete(fmt.Sprintf("blog_group:%s", groupID))
+}
+
+// InvalidateBlogCards tüm blog kartı listelerinin cache'ini temizler
+func (s *BlogCacheService) InvalidateBlogCards() {
+	s.cache.ClearPrefix("blog_cards:")
+}
+
+// InvalidateBlogSearch tüm arama sonuçlarının ve facet'lerin cache'ini temizler
+func (s *BlogCacheService) InvalidateBlogSearch() {
+	s.cache.ClearPrefix("blog_search:")
+}
+
+// InvalidateRecentPosts son eklenen blog yazıları cache'ini temizler
+func (s *BlogCacheService) InvalidateRecentPosts() {
+	s.cache.Delete("recent_posts")
+}
+
+// InvalidateSitemap sitemap cache'ini temizler
+func (s *BlogCacheService) InvalidateSitemap() {
+	s.cache.Delete("sitemap")
+}
+
+// InvalidatePublishedBlog yeni yayınlanan bir blogun görünür olduğu tüm cache'leri temizler
+func (s *BlogCacheService) InvalidatePublishedBlog(blog types.BlogPost) {
+	s.InvalidateBlogByID(blog.ID)
+	s.InvalidateBlogBySlug(blog.GroupID)
+	s.InvalidateBlogCards()
+	s.InvalidateBlogSearch()
+	s.InvalidateRecentPosts()
+	s.InvalidateSitemap()
+	s.InvalidateAllFeaturedPosts()
+	s.cache.Delete("featured_posts")
+	s.cache.ClearPrefix("related_posts:")
+}
+
 func (s *BlogCacheService) GetRelatedPosts(blogID uuid.UUID, categories []string, tags []string, language string) ([]types.BlogPostCardView, bool) {
 	cacheKey := fmt.Sprintf("related_posts:%s:%s:%s:%s",
 		blogID.String(), language, strings.Join(categories, "_"), strings.Join(tags, "_"))
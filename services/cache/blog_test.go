@@ -0,0 +1,102 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+)
+
+func TestGenerateBlogCardsCacheKey(t *testing.T) {
+	c := NewCache(time.Hour)
+	t.Cleanup(c.Stop)
+	s := NewBlogCacheService(c)
+
+	authorID := uuid.MustParse("7f1c1f0e-4a39-4c8e-9a59-1e0c7f0b2d11")
+	zero, five := 0, 5
+
+	tests := []struct {
+		name     string
+		a, b     types.BlogCardQueryOptions
+		wantSame bool
+	}{
+		{
+			name:     "category order",
+			a:        types.BlogCardQueryOptions{CategoryValues: []string{"food", "travel", "hotels"}},
+			b:        types.BlogCardQueryOptions{CategoryValues: []string{"hotels", "food", "travel"}},
+			wantSame: true,
+		},
+		{
+			name:     "tag order",
+			a:        types.BlogCardQueryOptions{TagValues: []string{"beach", "family"}},
+			b:        types.BlogCardQueryOptions{TagValues: []string{"family", "beach"}},
+			wantSame: true,
+		},
+		{
+			name:     "tag duplicates",
+			a:        types.BlogCardQueryOptions{TagValues: []string{"beach", "family", "beach"}},
+			b:        types.BlogCardQueryOptions{TagValues: []string{"family", "beach"}},
+			wantSame: true,
+		},
+		{
+			name:     "excluded category order",
+			a:        types.BlogCardQueryOptions{ExcludeCategoryValues: []string{"news", "events"}},
+			b:        types.BlogCardQueryOptions{ExcludeCategoryValues: []string{"events", "news"}},
+			wantSame: true,
+		},
+		{
+			name:     "different values",
+			a:        types.BlogCardQueryOptions{CategoryValues: []string{"food"}},
+			b:        types.BlogCardQueryOptions{CategoryValues: []string{"travel"}},
+			wantSame: false,
+		},
+		{
+			name:     "same value in different filters",
+			a:        types.BlogCardQueryOptions{CategoryValues: []string{"food"}},
+			b:        types.BlogCardQueryOptions{ExcludeCategoryValues: []string{"food"}},
+			wantSame: false,
+		},
+		{
+			name:     "categories vs tags",
+			a:        types.BlogCardQueryOptions{CategoryValues: []string{"food"}},
+			b:        types.BlogCardQueryOptions{TagValues: []string{"food"}},
+			wantSame: false,
+		},
+		{
+			name:     "author",
+			a:        types.BlogCardQueryOptions{AuthorID: &authorID},
+			b:        types.BlogCardQueryOptions{},
+			wantSame: false,
+		},
+		{
+			name:     "zero min read time vs none",
+			a:        types.BlogCardQueryOptions{MinReadTime: &zero},
+			b:        types.BlogCardQueryOptions{},
+			wantSame: false,
+		},
+		{
+			name:     "min vs max read time",
+			a:        types.BlogCardQueryOptions{MinReadTime: &five},
+			b:        types.BlogCardQueryOptions{MaxReadTime: &five},
+			wantSame: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keyA := s.GenerateBlogCardsCacheKey(tt.a)
+			keyB := s.GenerateBlogCardsCacheKey(tt.b)
+			if (keyA == keyB) != tt.wantSame {
+				t.Errorf("keys equal = %v, want %v (%s, %s)", keyA == keyB, tt.wantSame, keyA, keyB)
+			}
+		})
+	}
+
+	// Key üretimi çağıranın slice'ını yerinde sıralamamalı
+	values := []string{"travel", "food"}
+	s.GenerateBlogCardsCacheKey(types.BlogCardQueryOptions{CategoryValues: values})
+	if values[0] != "travel" || values[1] != "food" {
+		t.Errorf("input slice reordered: %v", values)
+	}
+}
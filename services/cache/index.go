@@ -147,6 +147,16 @@ func (c *Cache) ClearAIRateLimits() {
 	}
 }
 
+// ProtectedPrefixes blog cache temizliklerinde korunan (blog dışı) anahtar önekleri
+var ProtectedPrefixes = []string{
+	"ai_rate_limit:",
+	"ai_rate_limit_minute:",
+	"session_invalidated:",
+	"login_attempt:",
+	"two_factor_challenge:",
+	"rate_limit:",
+}
+
 // ClearExceptPrefixes belirli öneklerle başlayan anahtarlar dışındaki tüm anahtarları temizler
 func (c *Cache) ClearExceptPrefixes(prefixes []string) {
 	c.mu.Lock()
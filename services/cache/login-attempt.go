@@ -0,0 +1,177 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+)
+
+const loginAttemptPrefix = "login_attempt:"
+
+// Cache üzerinde oku-değiştir-yaz işlemlerinin paralel isteklerde sayaç kaybetmemesi için
+var loginAttemptMu sync.Mutex
+
+// LoginAttemptCacheService başarısız giriş denemelerini kullanıcı adı ve IP bazında cache üzerinde tutar.
+// Eşik aşıldığında her yeni başarısız denemede kilit süresi katlanarak artar.
+type LoginAttemptCacheService struct {
+	cache *Cache
+}
+
+// NewLoginAttemptCacheService yeni bir LoginAttemptCacheService oluşturur
+func NewLoginAttemptCacheService(cache *Cache) *LoginAttemptCacheService {
+	return &LoginAttemptCacheService{
+		cache: cache,
+	}
+}
+
+// CheckLockout kullanıcı adı veya IP kilitliyse kalan süreyi döndürür
+func (s *LoginAttemptCacheService) CheckLockout(username string, ip string) (time.Duration, bool) {
+	now := time.Now()
+	var retryAfter time.Duration
+
+	for _, info := range []*types.LoginAttemptInfo{
+		s.get(types.LoginAttemptScopeUsername, username),
+		s.get(types.LoginAttemptScopeIP, ip),
+	} {
+		if info != nil && info.IsLocked(now) {
+			retryAfter = maxDuration(retryAfter, info.LockedUntil.Sub(now))
+		}
+	}
+
+	return retryAfter, retryAfter > 0
+}
+
+// RegisterFailure başarısız bir denemeyi kaydeder; deneme kilide yol açtıysa kilit süresini döndürür
+func (s *LoginAttemptCacheService) RegisterFailure(username string, ip string) (time.Duration, bool) {
+	loginAttemptMu.Lock()
+	defer loginAttemptMu.Unlock()
+
+	now := time.Now()
+	usernameLock := s.registerFailure(types.LoginAttemptScopeUsername, username, configs.LOGIN_MAX_FAILURES_PER_USERNAME, now)
+	ipLock := s.registerFailure(types.LoginAttemptScopeIP, ip, configs.LOGIN_MAX_FAILURES_PER_IP, now)
+
+	retryAfter := maxDuration(usernameLock, ipLock)
+	return retryAfter, retryAfter > 0
+}
+
+// RegisterSuccess başarılı girişte kullanıcı adı sayacını sıfırlar.
+// IP sayacı korunur; aksi halde geçerli bir hesaba sahip saldırgan diğer hesaplar için sayacı sıfırlayabilirdi.
+func (s *LoginAttemptCacheService) RegisterSuccess(username string) {
+	s.cache.Delete(loginAttemptKey(types.LoginAttemptScopeUsername, username))
+}
+
+// GetAll cache'deki tüm başarısız giriş kayıtlarını döndürür
+func (s *LoginAttemptCacheService) GetAll() []types.LoginAttemptInfo {
+	attempts := []types.LoginAttemptInfo{}
+	for _, data := range s.cache.GetAllWithPrefix(loginAttemptPrefix) {
+		var info types.LoginAttemptInfo
+		if err := json.Unmarshal(data, &info); err == nil {
+			attempts = append(attempts, info)
+		}
+	}
+	return attempts
+}
+
+// Clear belirli bir kullanıcı adı veya IP için kaydı siler, kayıt yoksa false döner
+func (s *LoginAttemptCacheService) Clear(scope types.LoginAttemptScope, identifier string) bool {
+	key := loginAttemptKey(scope, identifier)
+	if _, exists := s.cache.Get(key); !exists {
+		return false
+	}
+	s.cache.Delete(key)
+	return true
+}
+
+// ClearAll tüm başarısız giriş kayıtlarını siler
+func (s *LoginAttemptCacheService) ClearAll() {
+	s.cache.ClearPrefix(loginAttemptPrefix)
+}
+
+func (s *LoginAttemptCacheService) registerFailure(scope types.LoginAttemptScope, identifier string, threshold int, now time.Time) time.Duration {
+	if identifier == "" {
+		return 0
+	}
+
+	info := s.get(scope, identifier)
+	if info == nil || now.Sub(info.LastFailure) > configs.LOGIN_ATTEMPT_WINDOW {
+		info = &types.LoginAttemptInfo{
+			Scope:        scope,
+			Identifier:   normalizeLoginIdentifier(scope, identifier),
+			FirstFailure: now,
+		}
+	}
+
+	info.Failures++
+	info.LastFailure = now
+
+	lockDuration := LoginLockoutDuration(info.Failures, threshold)
+	if lockDuration > 0 {
+		info.LockedUntil = now.Add(lockDuration)
+	}
+
+	if data, err := json.Marshal(info); err == nil {
+		// Kayıt, kilit bitene veya deneme penceresi dolana kadar (hangisi uzunsa) tutulur
+		s.cache.SetWithTTL(loginAttemptKey(scope, identifier), data, maxDuration(configs.LOGIN_ATTEMPT_WINDOW, lockDuration))
+	}
+
+	return lockDuration
+}
+
+func (s *LoginAttemptCacheService) get(scope types.LoginAttemptScope, identifier string) *types.LoginAttemptInfo {
+	if identifier == "" {
+		return nil
+	}
+
+	data, exists := s.cache.Get(loginAttemptKey(scope, identifier))
+	if !exists {
+		return nil
+	}
+
+	var info types.LoginAttemptInfo
+	if err := json.Unmarshal(data, &info); err != nil {
+		return nil
+	}
+	return &info
+}
+
+// LoginLockoutDuration başarısız deneme sayısına göre kilit süresini hesaplar.
+// Eşiğe ulaşıldığında temel süre uygulanır, sonraki her denemede süre iki katına çıkar.
+func LoginLockoutDuration(failures int, threshold int) time.Duration {
+	if failures < threshold {
+		return 0
+	}
+
+	duration := configs.LOGIN_LOCKOUT_BASE_DURATION
+	for i := threshold; i < failures; i++ {
+		duration *= 2
+		if duration >= configs.LOGIN_LOCKOUT_MAX_DURATION {
+			return configs.LOGIN_LOCKOUT_MAX_DURATION
+		}
+	}
+
+	return duration
+}
+
+func loginAttemptKey(scope types.LoginAttemptScope, identifier string) string {
+	return fmt.Sprintf("%s%s:%s", loginAttemptPrefix, scope, normalizeLoginIdentifier(scope, identifier))
+}
+
+func normalizeLoginIdentifier(scope types.LoginAttemptScope, identifier string) string {
+	identifier = strings.TrimSpace(identifier)
+	if scope == types.LoginAttemptScopeUsername {
+		return strings.ToLower(identifier)
+	}
+	return identifier
+}
+
+func maxDuration(a, b time.Duration) time.Duration {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,223 @@
+package cache
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+)
+
+func newTestLoginAttemptService(t *testing.T) *LoginAttemptCacheService {
+	t.Helper()
+	c := NewCache(time.Hour)
+	t.Cleanup(c.Stop)
+	return NewLoginAttemptCacheService(c)
+}
+
+func TestLoginLockoutDuration(t *testing.T) {
+	tests := []struct {
+		failures  int
+		threshold int
+		want      time.Duration
+	}{
+		{0, 5, 0},
+		{4, 5, 0},
+		{5, 5, configs.LOGIN_LOCKOUT_BASE_DURATION},
+		{6, 5, 2 * configs.LOGIN_LOCKOUT_BASE_DURATION},
+		{7, 5, 4 * configs.LOGIN_LOCKOUT_BASE_DURATION},
+		{9, 5, 16 * configs.LOGIN_LOCKOUT_BASE_DURATION},
+		{10, 5, configs.LOGIN_LOCKOUT_MAX_DURATION},
+		{100, 5, configs.LOGIN_LOCKOUT_MAX_DURATION},
+		{19, 20, 0},
+		{20, 20, configs.LOGIN_LOCKOUT_BASE_DURATION},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d/%d", tt.failures, tt.threshold), func(t *testing.T) {
+			if got := LoginLockoutDuration(tt.failures, tt.threshold); got != tt.want {
+				t.Errorf("LoginLockoutDuration(%d, %d) = %v, want %v", tt.failures, tt.threshold, got, tt.want)
+			}
+		})
+	}
+}
+
+type loginAttempt struct {
+	username string
+	ip       string
+}
+
+// sameUsername aynı kullanıcı adıyla farklı IP'lerden n deneme üretir
+func sameUsername(username string, n int) []loginAttempt {
+	attempts := make([]loginAttempt, n)
+	for i := range attempts {
+		attempts[i] = loginAttempt{username, fmt.Sprintf("10.0.0.%d", i+1)}
+	}
+	return attempts
+}
+
+// sameIP aynı IP'den farklı kullanıcı adlarıyla n deneme üretir
+func sameIP(ip string, n int) []loginAttempt {
+	attempts := make([]loginAttempt, n)
+	for i := range attempts {
+		attempts[i] = loginAttempt{fmt.Sprintf("user%d", i+1), ip}
+	}
+	return attempts
+}
+
+func TestRegisterFailure(t *testing.T) {
+	tests := []struct {
+		name       string
+		attempts   []loginAttempt
+		wantLocked bool
+		wantRetry  time.Duration
+	}{
+		{"below username threshold", sameUsername("admin", configs.LOGIN_MAX_FAILURES_PER_USERNAME-1), false, 0},
+		{"username threshold", sameUsername("admin", configs.LOGIN_MAX_FAILURES_PER_USERNAME), true, configs.LOGIN_LOCKOUT_BASE_DURATION},
+		{"username past threshold", sameUsername("admin", configs.LOGIN_MAX_FAILURES_PER_USERNAME+1), true, 2 * configs.LOGIN_LOCKOUT_BASE_DURATION},
+		{"below ip threshold", sameIP("10.0.0.1", configs.LOGIN_MAX_FAILURES_PER_IP-1), false, 0},
+		{"ip threshold", sameIP("10.0.0.1", configs.LOGIN_MAX_FAILURES_PER_IP), true, configs.LOGIN_LOCKOUT_BASE_DURATION},
+		{"username is case insensitive", []loginAttempt{
+			{"Admin", "10.0.0.1"}, {"admin", "10.0.0.2"}, {" ADMIN ", "10.0.0.3"}, {"admin", "10.0.0.4"}, {"aDmIn", "10.0.0.5"},
+		}, true, configs.LOGIN_LOCKOUT_BASE_DURATION},
+		{"empty identifiers are ignored", []loginAttempt{{"", ""}, {"", ""}, {"", ""}, {"", ""}, {"", ""}}, false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestLoginAttemptService(t)
+
+			var retryAfter time.Duration
+			var locked bool
+			for _, attempt := range tt.attempts {
+				retryAfter, locked = s.RegisterFailure(attempt.username, attempt.ip)
+			}
+
+			if locked != tt.wantLocked || retryAfter != tt.wantRetry {
+				t.Errorf("RegisterFailure() = (%v, %v), want (%v, %v)", retryAfter, locked, tt.wantRetry, tt.wantLocked)
+			}
+		})
+	}
+}
+
+func TestRegisterFailureWindowExpiry(t *testing.T) {
+	s := newTestLoginAttemptService(t)
+
+	// Deneme penceresinden önceki başarısız denemeler sayaca eklenmez
+	past := time.Now().Add(-configs.LOGIN_ATTEMPT_WINDOW - time.Minute)
+	for range configs.LOGIN_MAX_FAILURES_PER_USERNAME - 1 {
+		s.registerFailure(types.LoginAttemptScopeUsername, "admin", configs.LOGIN_MAX_FAILURES_PER_USERNAME, past)
+	}
+
+	if _, locked := s.RegisterFailure("admin", "10.0.0.1"); locked {
+		t.Fatal("RegisterFailure() locked after the attempt window expired")
+	}
+
+	info := s.get(types.LoginAttemptScopeUsername, "admin")
+	if info == nil || info.Failures != 1 {
+		t.Fatalf("failures = %v, want 1", info)
+	}
+}
+
+func TestCheckLockout(t *testing.T) {
+	tests := []struct {
+		name       string
+		setup      func(s *LoginAttemptCacheService)
+		username   string
+		ip         string
+		wantLocked bool
+	}{
+		{
+			name:       "no records",
+			setup:      func(s *LoginAttemptCacheService) {},
+			username:   "admin",
+			ip:         "10.0.0.1",
+			wantLocked: false,
+		},
+		{
+			name: "username locked from any ip",
+			setup: func(s *LoginAttemptCacheService) {
+				for _, a := range sameUsername("admin", configs.LOGIN_MAX_FAILURES_PER_USERNAME) {
+					s.RegisterFailure(a.username, a.ip)
+				}
+			},
+			username:   "ADMIN",
+			ip:         "192.168.1.1",
+			wantLocked: true,
+		},
+		{
+			name: "ip locked for any username",
+			setup: func(s *LoginAttemptCacheService) {
+				for _, a := range sameIP("10.0.0.1", configs.LOGIN_MAX_FAILURES_PER_IP) {
+					s.RegisterFailure(a.username, a.ip)
+				}
+			},
+			username:   "someone-else",
+			ip:         "10.0.0.1",
+			wantLocked: true,
+		},
+		{
+			name: "other username and ip unaffected",
+			setup: func(s *LoginAttemptCacheService) {
+				for _, a := range sameUsername("admin", configs.LOGIN_MAX_FAILURES_PER_USERNAME) {
+					s.RegisterFailure(a.username, a.ip)
+				}
+			},
+			username:   "editor",
+			ip:         "192.168.1.1",
+			wantLocked: false,
+		},
+		{
+			name: "expired lock",
+			setup: func(s *LoginAttemptCacheService) {
+				past := time.Now().Add(-time.Hour)
+				for range configs.LOGIN_MAX_FAILURES_PER_USERNAME {
+					s.registerFailure(types.LoginAttemptScopeUsername, "admin", configs.LOGIN_MAX_FAILURES_PER_USERNAME, past)
+				}
+			},
+			username:   "admin",
+			ip:         "10.0.0.1",
+			wantLocked: false,
+		},
+		{
+			name: "success clears username but keeps ip lock",
+			setup: func(s *LoginAttemptCacheService) {
+				for range configs.LOGIN_MAX_FAILURES_PER_IP {
+					s.RegisterFailure("admin", "10.0.0.1")
+				}
+				s.RegisterSuccess("admin")
+			},
+			username:   "admin",
+			ip:         "10.0.0.1",
+			wantLocked: true,
+		},
+		{
+			name: "success clears username lock",
+			setup: func(s *LoginAttemptCacheService) {
+				for _, a := range sameUsername("admin", configs.LOGIN_MAX_FAILURES_PER_USERNAME) {
+					s.RegisterFailure(a.username, a.ip)
+				}
+				s.RegisterSuccess("admin")
+			},
+			username:   "admin",
+			ip:         "192.168.1.1",
+			wantLocked: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestLoginAttemptService(t)
+			tt.setup(s)
+
+			retryAfter, locked := s.CheckLockout(tt.username, tt.ip)
+			if locked != tt.wantLocked {
+				t.Errorf("CheckLockout() locked = %v, want %v", locked, tt.wantLocked)
+			}
+			if locked && retryAfter <= 0 {
+				t.Errorf("CheckLockout() retryAfter = %v, want > 0", retryAfter)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package cache
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+const rateLimitPrefix = "rate_limit:"
+
+// Cache üzerinde oku-değiştir-yaz işlemlerinin paralel isteklerde sayaç kaybetmemesi için
+var rateLimitMu sync.Mutex
+
+// RateLimitCacheService herkese açık uç noktalar için sabit pencereli istek sayacı tutar
+type RateLimitCacheService struct {
+	cache *Cache
+}
+
+// rateLimitWindow tek bir anahtarın penceredeki istek sayısı
+type rateLimitWindow struct {
+	Count   int       `json:"count"`
+	ResetAt time.Time `json:"resetAt"`
+}
+
+// NewRateLimitCacheService yeni bir RateLimitCacheService oluşturur
+func NewRateLimitCacheService(cache *Cache) *RateLimitCacheService {
+	return &RateLimitCacheService{
+		cache: cache,
+	}
+}
+
+// Allow isteği sayar; pencere içinde limit aşıldıysa pencerenin bitmesine kalan süreyle false döner
+func (s *RateLimitCacheService) Allow(scope string, identifier string, limit int, window time.Duration) (time.Duration, bool) {
+	rateLimitMu.Lock()
+	defer rateLimitMu.Unlock()
+
+	now := time.Now()
+	key := rateLimitPrefix + scope + ":" + identifier
+
+	var current rateLimitWindow
+	if data, exists := s.cache.Get(key); exists {
+		if err := json.Unmarshal(data, &current); err != nil || !current.ResetAt.After(now) {
+			current = rateLimitWindow{}
+		}
+	}
+
+	if current.ResetAt.IsZero() {
+		current.ResetAt = now.Add(window)
+	}
+
+	if current.Count >= limit {
+		return current.ResetAt.Sub(now), false
+	}
+
+	current.Count++
+	if data, err := json.Marshal(current); err == nil {
+		s.cache.SetWithTTL(key, data, current.ResetAt.Sub(now))
+	}
+
+	return 0, true
+}
@@ -0,0 +1,64 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimitAllow(t *testing.T) {
+	c := NewCache(time.Hour)
+	t.Cleanup(c.Stop)
+	s := NewRateLimitCacheService(c)
+
+	for i := range 3 {
+		if _, allowed := s.Allow("test", "10.0.0.1", 3, time.Minute); !allowed {
+			t.Fatalf("request %d rejected, want allowed", i+1)
+		}
+	}
+
+	retryAfter, allowed := s.Allow("test", "10.0.0.1", 3, time.Minute)
+	if allowed || retryAfter <= 0 || retryAfter > time.Minute {
+		t.Errorf("Allow() over limit = (%v, %v), want (0 < retryAfter <= 1m, false)", retryAfter, allowed)
+	}
+
+	if _, allowed := s.Allow("test", "10.0.0.2", 3, time.Minute); !allowed {
+		t.Error("other ip rejected, want allowed")
+	}
+	if _, allowed := s.Allow("other", "10.0.0.1", 3, time.Minute); !allowed {
+		t.Error("other scope rejected, want allowed")
+	}
+}
+
+func TestRateLimitWindowReset(t *testing.T) {
+	c := NewCache(time.Hour)
+	t.Cleanup(c.Stop)
+	s := NewRateLimitCacheService(c)
+
+	window := 50 * time.Millisecond
+	s.Allow("test", "10.0.0.1", 1, window)
+	if _, allowed := s.Allow("test", "10.0.0.1", 1, window); allowed {
+		t.Fatal("second request allowed, want rejected")
+	}
+
+	time.Sleep(window + 10*time.Millisecond)
+	if _, allowed := s.Allow("test", "10.0.0.1", 1, window); !allowed {
+		t.Error("request after window rejected, want allowed")
+	}
+}
+
+func TestRateLimitSurvivesBlogCacheClear(t *testing.T) {
+	c := NewCache(time.Hour)
+	t.Cleanup(c.Stop)
+	s := NewRateLimitCacheService(c)
+
+	if _, allowed := s.Allow("test", "10.0.0.1", 1, time.Minute); !allowed {
+		t.Fatal("first request rejected, want allowed")
+	}
+
+	// Blog kaydedildiğinde çalışan InvalidateAllBlogs ile aynı temizlik
+	c.ClearExceptPrefixes(ProtectedPrefixes)
+
+	if _, allowed := s.Allow("test", "10.0.0.1", 1, time.Minute); allowed {
+		t.Error("counter was reset by the blog cache clear, want rejected")
+	}
+}
@@ -0,0 +1,51 @@
+package cache
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+)
+
+// SessionCacheService oturum geçersiz kılma işaretlerini cache üzerinde tutar.
+// Access token'lar kısa ömürlü JWT olduğundan, işaretli kullanıcının istekleri
+// token süresi dolana kadar veritabanı üzerinden yeniden doğrulanır.
+type SessionCacheService struct {
+	cache *Cache
+}
+
+// NewSessionCacheService yeni bir SessionCacheService oluşturur
+func NewSessionCacheService(cache *Cache) *SessionCacheService {
+	return &SessionCacheService{
+		cache: cache,
+	}
+}
+
+// InvalidateUserSessions kullanıcının mevcut access token'larını bir sonraki istekte geçersiz kılar
+func (s *SessionCacheService) InvalidateUserSessions(userID uuid.UUID) {
+	cacheKey := fmt.Sprintf("session_invalidated:%s", userID.String())
+	value := []byte(strconv.FormatInt(time.Now().Unix(), 10))
+
+	// Mevcut access token'lar en fazla ACCESS_TOKEN_DURATION kadar yaşayabilir
+	s.cache.SetWithTTL(cacheKey, value, configs.ACCESS_TOKEN_DURATION)
+}
+
+// IsUserSessionInvalidated token'ın kullanıcının oturumları geçersiz kılınmadan önce oluşturulup oluşturulmadığını kontrol eder.
+// Geçersiz kılmadan sonra yenilenen token'lar geçerli sayılır; JWT "iat" saniye hassasiyetinde olduğundan
+// aynı saniyede oluşturulan token'lar eski kabul edilir.
+func (s *SessionCacheService) IsUserSessionInvalidated(userID uuid.UUID, issuedAt time.Time) bool {
+	cacheKey := fmt.Sprintf("session_invalidated:%s", userID.String())
+	value, exists := s.cache.Get(cacheKey)
+	if !exists {
+		return false
+	}
+
+	invalidatedAt, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil {
+		return true
+	}
+
+	return issuedAt.Unix() <= invalidatedAt
+}
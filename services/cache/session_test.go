@@ -0,0 +1,46 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestIsUserSessionInvalidated(t *testing.T) {
+	c := NewCache(time.Hour)
+	t.Cleanup(c.Stop)
+	s := NewSessionCacheService(c)
+
+	userID := uuid.New()
+	otherID := uuid.New()
+	before := time.Now().Add(-time.Minute)
+
+	if s.IsUserSessionInvalidated(userID, before) {
+		t.Fatal("no invalidation recorded, want valid")
+	}
+
+	s.InvalidateUserSessions(userID)
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		userID   uuid.UUID
+		issuedAt time.Time
+		want     bool
+	}{
+		{"token issued before invalidation", userID, before, true},
+		{"token issued in the same second", userID, now, true},
+		{"token issued after invalidation", userID, now.Add(2 * time.Second), false},
+		{"zero issued at", userID, time.Time{}, true},
+		{"other user", otherID, before, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.IsUserSessionInvalidated(tt.userID, tt.issuedAt); got != tt.want {
+				t.Errorf("IsUserSessionInvalidated() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package cache
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+	"github.com/okanay/backend-blog-guideofdubai/utils"
+)
+
+const twoFactorChallengePrefix = "two_factor_challenge:"
+
+var twoFactorChallengeMu sync.Mutex
+
+// TwoFactorChallengeCacheService şifresi doğrulanmış ve ikinci adımı bekleyen girişleri tutar.
+// Challenge tokenının kendisi değil hash'i anahtar olarak kullanılır.
+type TwoFactorChallengeCacheService struct {
+	cache *Cache
+}
+
+// NewTwoFactorChallengeCacheService yeni bir TwoFactorChallengeCacheService oluşturur
+func NewTwoFactorChallengeCacheService(cache *Cache) *TwoFactorChallengeCacheService {
+	return &TwoFactorChallengeCacheService{
+		cache: cache,
+	}
+}
+
+// Create kullanıcı için yeni bir challenge oluşturur ve tokenı döndürür
+func (s *TwoFactorChallengeCacheService) Create(userID uuid.UUID) (string, types.TwoFactorChallenge) {
+	token := utils.GenerateRandomString(configs.TWO_FACTOR_CHALLENGE_LENGTH)
+	challenge := types.TwoFactorChallenge{
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(configs.TWO_FACTOR_CHALLENGE_DURATION),
+	}
+
+	s.save(token, challenge)
+	return token, challenge
+}
+
+// Get geçerli bir challenge varsa döndürür
+func (s *TwoFactorChallengeCacheService) Get(token string) (*types.TwoFactorChallenge, bool) {
+	if token == "" {
+		return nil, false
+	}
+
+	data, exists := s.cache.Get(twoFactorChallengePrefix + utils.HashToken(token))
+	if !exists {
+		return nil, false
+	}
+
+	var challenge types.TwoFactorChallenge
+	if err := json.Unmarshal(data, &challenge); err != nil {
+		return nil, false
+	}
+
+	if challenge.ExpiresAt.Before(time.Now()) {
+		return nil, false
+	}
+
+	return &challenge, true
+}
+
+// RegisterFailedAttempt hatalı kod denemesini kaydeder ve kalan deneme hakkını döndürür.
+// Deneme hakkı bittiğinde challenge silinir ve kullanıcı şifre adımından tekrar başlamalıdır.
+func (s *TwoFactorChallengeCacheService) RegisterFailedAttempt(token string) int {
+	twoFactorChallengeMu.Lock()
+	defer twoFactorChallengeMu.Unlock()
+
+	challenge, ok := s.Get(token)
+	if !ok {
+		return 0
+	}
+
+	challenge.Attempts++
+	remaining := configs.TWO_FACTOR_MAX_ATTEMPTS - challenge.Attempts
+	if remaining <= 0 {
+		s.Delete(token)
+		return 0
+	}
+
+	s.save(token, *challenge)
+	return remaining
+}
+
+// Delete challenge'ı siler (başarılı doğrulama sonrası tekrar kullanılamaz)
+func (s *TwoFactorChallengeCacheService) Delete(token string) {
+	s.cache.Delete(twoFactorChallengePrefix + utils.HashToken(token))
+}
+
+func (s *TwoFactorChallengeCacheService) save(token string, challenge types.TwoFactorChallenge) {
+	ttl := time.Until(challenge.ExpiresAt)
+	if ttl <= 0 {
+		return
+	}
+
+	if data, err := json.Marshal(challenge); err == nil {
+		s.cache.SetWithTTL(twoFactorChallengePrefix+utils.HashToken(token), data, ttl)
+	}
+}
@@ -0,0 +1,100 @@
+package mailer
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Message gönderilecek e-postayı temsil eder
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer e-posta gönderimi için ortak arayüz. Yeni bir sağlayıcı (SMTP, SES vb.)
+// eklemek için bu arayüzü uygulamak yeterlidir.
+type Mailer interface {
+	Send(message Message) error
+}
+
+// NewMailer MAILER_DRIVER çevresel değişkenine göre uygun mailer'ı oluşturur.
+// "log" ve "file" sürücüleri token içeren e-postaları açıkça yazdığı için
+// yalnızca APP_ENV="development" iken kullanılabilir.
+func NewMailer() Mailer {
+	from := os.Getenv("MAILER_FROM")
+	driver := os.Getenv("MAILER_DRIVER")
+	development := os.Getenv("APP_ENV") == "development"
+
+	switch driver {
+	case "log", "file":
+		if !development {
+			log.Fatalf("[MAILER]: MAILER_DRIVER=%q sadece APP_ENV=\"development\" iken kullanılabilir", driver)
+		}
+		if driver == "log" {
+			return &LogMailer{From: from}
+		}
+		dir := os.Getenv("MAILER_FILE_DIR")
+		if dir == "" {
+			dir = "./tmp/mails"
+		}
+		return &FileMailer{Dir: dir, From: from}
+	case "":
+		if development {
+			return &LogMailer{From: from}
+		}
+		log.Println("[MAILER]: !!! UYARI: MAILER_DRIVER tanımlı değil, e-posta gönderimi devre dışı. Şifre sıfırlama ve e-posta doğrulama e-postaları gönderilmeyecek !!!")
+		return &DisabledMailer{}
+	default:
+		log.Fatalf("[MAILER]: Bilinmeyen MAILER_DRIVER değeri: %q", driver)
+		return nil
+	}
+}
+
+// DisabledMailer hiçbir sürücü yapılandırılmadığında kullanılır; içeriği
+// loglamadan her gönderimi hata olarak döndürür
+type DisabledMailer struct{}
+
+func (m *DisabledMailer) Send(message Message) error {
+	return fmt.Errorf("mailer is disabled, no MAILER_DRIVER configured")
+}
+
+// LogMailer e-postaları sadece loglar (lokal geliştirme için)
+type LogMailer struct {
+	From string
+}
+
+func (m *LogMailer) Send(message Message) error {
+	log.Printf("[MAILER]: To: %s | Subject: %s\n%s", message.To, message.Subject, message.Body)
+	return nil
+}
+
+// FileMailer e-postaları .eml dosyası olarak diske yazar (lokal geliştirme için)
+type FileMailer struct {
+	Dir  string
+	From string
+}
+
+func (m *FileMailer) Send(message Message) error {
+	if err := os.MkdirAll(m.Dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create mail directory: %w", err)
+	}
+
+	recipient := strings.NewReplacer("@", "_at_", "/", "_", "\\", "_").Replace(message.To)
+	filename := fmt.Sprintf("%s_%s.eml", time.Now().Format("20060102-150405.000000"), recipient)
+
+	content := fmt.Sprintf(
+		"From: %s\r\nTo: %s\r\nSubject: %s\r\nDate: %s\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\n%s\r\n",
+		m.From, message.To, message.Subject, time.Now().Format(time.RFC1123Z), message.Body,
+	)
+
+	if err := os.WriteFile(filepath.Join(m.Dir, filename), []byte(content), 0o644); err != nil {
+		return fmt.Errorf("failed to write mail file: %w", err)
+	}
+
+	return nil
+}
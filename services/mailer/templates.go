@@ -0,0 +1,54 @@
+package mailer
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+)
+
+// PasswordResetMessage şifre sıfırlama e-postasını oluşturur
+func PasswordResetMessage(to string, username string, token string) Message {
+	link := buildLink(os.Getenv("PASSWORD_RESET_URL"), token)
+
+	return Message{
+		To:      to,
+		Subject: fmt.Sprintf("%s - Şifre Sıfırlama", configs.PROJECT_NAME),
+		Body: fmt.Sprintf(
+			"Merhaba %s,\n\nŞifrenizi sıfırlamak için aşağıdaki bağlantıyı kullanın:\n%s\n\nBağlantı %d dakika boyunca geçerlidir ve yalnızca bir kez kullanılabilir.\nBu isteği siz yapmadıysanız bu e-postayı dikkate almayın.",
+			username, link, int(configs.PASSWORD_RESET_TOKEN_DURATION.Minutes()),
+		),
+	}
+}
+
+// EmailVerificationMessage e-posta doğrulama e-postasını oluşturur
+func EmailVerificationMessage(to string, username string, token string) Message {
+	link := buildLink(os.Getenv("EMAIL_VERIFICATION_URL"), token)
+
+	return Message{
+		To:      to,
+		Subject: fmt.Sprintf("%s - E-posta Doğrulama", configs.PROJECT_NAME),
+		Body: fmt.Sprintf(
+			"Merhaba %s,\n\nHesabınızı doğrulamak için aşağıdaki bağlantıyı kullanın:\n%s\n\nBağlantı %d saat boyunca geçerlidir.",
+			username, link, int(configs.EMAIL_VERIFICATION_TOKEN_DURATION.Hours()),
+		),
+	}
+}
+
+// buildLink token'ı verilen bağlantıya ?token= parametresi olarak ekler
+func buildLink(base string, token string) string {
+	if base == "" {
+		return token
+	}
+
+	u, err := url.Parse(base)
+	if err != nil {
+		return base + "?token=" + url.QueryEscape(token)
+	}
+
+	query := u.Query()
+	query.Set("token", token)
+	u.RawQuery = query.Encode()
+	return u.String()
+}
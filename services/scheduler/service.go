@@ -0,0 +1,77 @@
+package SchedulerService
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	BlogRepository "github.com/okanay/backend-blog-guideofdubai/repositories/blog"
+	AuditService "github.com/okanay/backend-blog-guideofdubai/services/audit"
+	"github.com/okanay/backend-blog-guideofdubai/services/cache"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+)
+
+// SchedulerService zamanlanmış blogları arka planda yayınlayan servis
+type SchedulerService struct {
+	BlogRepo  *BlogRepository.Repository
+	BlogCache *cache.BlogCacheService
+	Audit     *AuditService.AuditService
+	interval  time.Duration
+	stop      chan struct{}
+	stopOnce  sync.Once
+}
+
+func NewSchedulerService(blogRepo *BlogRepository.Repository, c *cache.Cache, audit *AuditService.AuditService) *SchedulerService {
+	return &SchedulerService{
+		BlogRepo:  blogRepo,
+		BlogCache: cache.NewBlogCacheService(c),
+		Audit:     audit,
+		interval:  configs.SCHEDULER_INTERVAL,
+		stop:      make(chan struct{}),
+	}
+}
+
+// Start arka plan döngüsünü başlatır. Sunucu kapalıyken zamanı geçen bloglar ilk turda yayınlanır.
+func (s *SchedulerService) Start() {
+	go func() {
+		s.PublishDuePosts()
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				s.PublishDuePosts()
+			case <-s.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop arka plan döngüsünü durdurur
+func (s *SchedulerService) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stop)
+	})
+}
+
+// PublishDuePosts zamanı gelmiş blogları yayınlar ve ilgili cache'leri temizler
+func (s *SchedulerService) PublishDuePosts() {
+	posts, err := s.BlogRepo.PublishDueScheduledPosts()
+	if err != nil {
+		log.Printf("[SCHEDULER]: Zamanlanmış bloglar yayınlanırken hata: %v", err)
+		return
+	}
+
+	for _, post := range posts {
+		s.BlogCache.InvalidatePublishedBlog(post)
+		s.Audit.RecordSystem(types.AuditBlogScheduledPublish, types.AuditTargetBlog, post.ID.String(),
+			map[string]any{"status": types.BlogStatusScheduled, "scheduledAt": post.ScheduledAt},
+			map[string]any{"status": post.Status, "publishedAt": post.PublishedAt},
+		)
+		log.Printf("[SCHEDULER]: Blog yayınlandı: %s (%s)", post.Slug, post.Language)
+	}
+}
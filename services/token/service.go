@@ -0,0 +1,69 @@
+package TokenService
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	TokenRepository "github.com/okanay/backend-blog-guideofdubai/repositories/token"
+)
+
+// TokenCleanupService rotate edilmiş, iptal edilmiş ve süresi dolmuş refresh token satırlarını
+// saklama süresi dolduktan sonra arka planda siler. Saklama süresi boyunca rotate edilmiş tokenlar
+// tekrar kullanım tespiti için tutulur.
+type TokenCleanupService struct {
+	TokenRepo *TokenRepository.Repository
+	Retention time.Duration
+	interval  time.Duration
+	stop      chan struct{}
+	stopOnce  sync.Once
+}
+
+func NewTokenCleanupService(tokenRepo *TokenRepository.Repository) *TokenCleanupService {
+	return &TokenCleanupService{
+		TokenRepo: tokenRepo,
+		Retention: configs.REFRESH_TOKEN_RETENTION,
+		interval:  configs.REFRESH_TOKEN_CLEANUP_INTERVAL,
+		stop:      make(chan struct{}),
+	}
+}
+
+// Start arka plan döngüsünü başlatır
+func (s *TokenCleanupService) Start() {
+	go func() {
+		s.DeleteStaleTokens()
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				s.DeleteStaleTokens()
+			case <-s.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop arka plan döngüsünü durdurur
+func (s *TokenCleanupService) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stop)
+	})
+}
+
+// DeleteStaleTokens saklama süresi dolan token satırlarını siler
+func (s *TokenCleanupService) DeleteStaleTokens() {
+	deleted, err := s.TokenRepo.DeleteStaleTokens(s.Retention)
+	if err != nil {
+		log.Printf("[TOKEN CLEANUP]: Eski tokenlar silinirken hata: %v", err)
+		return
+	}
+
+	if deleted > 0 {
+		log.Printf("[TOKEN CLEANUP]: %d eski refresh token silindi", deleted)
+	}
+}
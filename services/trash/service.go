@@ -0,0 +1,145 @@
+package TrashService
+
+import (
+	"context"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	BlogRepository "github.com/okanay/backend-blog-guideofdubai/repositories/blog"
+	ImageRepository "github.com/okanay/backend-blog-guideofdubai/repositories/image"
+	R2Repository "github.com/okanay/backend-blog-guideofdubai/repositories/r2"
+	AuditService "github.com/okanay/backend-blog-guideofdubai/services/audit"
+	"github.com/okanay/backend-blog-guideofdubai/services/cache"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+)
+
+// TrashService çöp kutusundaki blogların kalıcı silinmesini yönetir. Saklama süresi dolan
+// bloglar arka planda silinir; featured kayıtları ve kullanılmayan R2 görselleri de temizlenir.
+type TrashService struct {
+	BlogRepo  *BlogRepository.Repository
+	ImageRepo *ImageRepository.Repository
+	R2Repo    *R2Repository.Repository
+	BlogCache *cache.BlogCacheService
+	Audit     *AuditService.AuditService
+	Retention time.Duration
+	interval  time.Duration
+	stop      chan struct{}
+	stopOnce  sync.Once
+}
+
+func NewTrashService(blogRepo *BlogRepository.Repository, imageRepo *ImageRepository.Repository, r2Repo *R2Repository.Repository, c *cache.Cache, audit *AuditService.AuditService) *TrashService {
+	return &TrashService{
+		BlogRepo:  blogRepo,
+		ImageRepo: imageRepo,
+		R2Repo:    r2Repo,
+		BlogCache: cache.NewBlogCacheService(c),
+		Audit:     audit,
+		Retention: retentionFromEnv(),
+		interval:  configs.TRASH_PURGE_INTERVAL,
+		stop:      make(chan struct{}),
+	}
+}
+
+// Start arka plan döngüsünü başlatır
+func (s *TrashService) Start() {
+	go func() {
+		s.PurgeExpiredPosts()
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				s.PurgeExpiredPosts()
+			case <-s.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop arka plan döngüsünü durdurur
+func (s *TrashService) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stop)
+	})
+}
+
+// PurgeAt çöp kutusuna taşınan bir blogun kalıcı olarak silineceği zamanı döndürür
+func (s *TrashService) PurgeAt(deletedAt time.Time) time.Time {
+	return deletedAt.Add(s.Retention)
+}
+
+// PurgeExpiredPosts saklama süresi dolmuş blogları kalıcı olarak siler
+func (s *TrashService) PurgeExpiredPosts() {
+	ids, err := s.BlogRepo.SelectExpiredTrashedBlogIDs(time.Now().Add(-s.Retention), configs.TRASH_PURGE_BATCH_MAX)
+	if err != nil {
+		log.Printf("[TRASH]: Süresi dolan bloglar alınırken hata: %v", err)
+		return
+	}
+
+	for _, id := range ids {
+		purged, err := s.PurgeBlog(context.Background(), id)
+		if err != nil {
+			log.Printf("[TRASH]: Blog kalıcı olarak silinemedi (%s): %v", id, err)
+			continue
+		}
+
+		s.Audit.RecordSystem(types.AuditBlogPurge, types.AuditTargetBlog, id.String(),
+			map[string]any{"slug": purged.Slug, "language": purged.Language, "status": types.BlogStatusDeleted},
+			nil,
+		)
+		log.Printf("[TRASH]: Blog kalıcı olarak silindi: %s (%s)", purged.Slug, purged.Language)
+	}
+}
+
+// PurgeBlog çöp kutusundaki bir blogu kalıcı olarak siler ve artık kullanılmayan görsellerini R2'den kaldırır.
+// Görsel silme hataları loglanır, blog silme işlemini başarısız saymaz.
+func (s *TrashService) PurgeBlog(ctx context.Context, blogID uuid.UUID) (*types.PurgedBlog, error) {
+	purged, err := s.BlogRepo.PurgeTrashedBlog(blogID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, url := range purged.OrphanImageURLs {
+		objectKey, ok := s.R2Repo.ObjectKeyFromURL(url)
+		if !ok {
+			continue
+		}
+
+		if err := s.R2Repo.DeleteObject(ctx, objectKey); err != nil {
+			log.Printf("[TRASH]: R2 görseli silinemedi: %v", err)
+		}
+	}
+
+	if err := s.ImageRepo.DeleteImagesByURL(ctx, purged.OrphanImageURLs); err != nil {
+		log.Printf("[TRASH]: Görsel kayıtları güncellenemedi: %v", err)
+	}
+
+	s.BlogCache.InvalidateBlogByID(blogID)
+	s.BlogCache.InvalidateAllBlogs()
+
+	return purged, nil
+}
+
+// retentionFromEnv TRASH_RETENTION_DAYS değerini okur; geçersizse varsayılan kullanılır
+func retentionFromEnv() time.Duration {
+	days := configs.TRASH_RETENTION_DAYS
+
+	if value := os.Getenv("TRASH_RETENTION_DAYS"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			log.Printf("[TRASH]: Geçersiz TRASH_RETENTION_DAYS değeri (%q), varsayılan %d gün kullanılıyor", value, days)
+		} else {
+			days = parsed
+		}
+	}
+
+	return time.Duration(days) * 24 * time.Hour
+}
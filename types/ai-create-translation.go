@@ -0,0 +1,92 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// CreateTranslationRequest kaynak blogdan hedef dillerde taslak çeviriler oluşturma isteği
+type CreateTranslationRequest struct {
+	SourceID        uuid.UUID `json:"sourceId" binding:"required"`
+	TargetLanguages []string  `json:"targetLanguages" binding:"required,min=1,max=10,dive,required"`
+}
+
+// TranslationLanguageStatus tek bir hedef dilin çeviri durumu
+type TranslationLanguageStatus string
+
+const (
+	TranslationStatusStarted TranslationLanguageStatus = "started"
+	TranslationStatusCreated TranslationLanguageStatus = "created"
+	TranslationStatusSkipped TranslationLanguageStatus = "skipped"
+	TranslationStatusFailed  TranslationLanguageStatus = "failed"
+)
+
+// TranslationLanguageResult bir hedef dil için oluşturulan çevirinin sonucu ve maliyeti
+type TranslationLanguageResult struct {
+	Language     string                    `json:"language"`
+	Status       TranslationLanguageStatus `json:"status"`
+	BlogID       string                    `json:"blogId,omitempty"`
+	Slug         string                    `json:"slug,omitempty"`
+	Error        string                    `json:"error,omitempty"`
+	Message      string                    `json:"message,omitempty"`
+	InputTokens  int                       `json:"inputTokens"`
+	OutputTokens int                       `json:"outputTokens"`
+	Cost         any                       `json:"cost,omitempty"`
+}
+
+// TranslationProgress çeviri sürecindeki her adımda yayınlanan ilerleme bilgisi
+type TranslationProgress struct {
+	Completed int                       `json:"completed"`
+	Total     int                       `json:"total"`
+	Result    TranslationLanguageResult `json:"result"`
+}
+
+// TranslationReport tüm hedef diller için çeviri özeti
+type TranslationReport struct {
+	SourceID     string                      `json:"sourceId"`
+	GroupID      string                      `json:"groupId"`
+	Created      int                         `json:"created"`
+	Skipped      int                         `json:"skipped"`
+	Failed       int                         `json:"failed"`
+	InputTokens  int                         `json:"inputTokens"`
+	OutputTokens int                         `json:"outputTokens"`
+	Cost         any                         `json:"cost"`
+	Languages    []TranslationLanguageResult `json:"languages"`
+}
+
+// TranslatedBlogFields blogun JSON dışındaki çevrilen alanları
+type TranslatedBlogFields struct {
+	ContentTitle        string `json:"contentTitle"`
+	ContentDescription  string `json:"contentDescription"`
+	MetadataTitle       string `json:"metadataTitle"`
+	MetadataDescription string `json:"metadataDescription"`
+	Slug                string `json:"slug"`
+}
+
+func GetTranslatedBlogFieldsSchema() ([]byte, error) {
+	field := func(description string) map[string]any {
+		return map[string]any{"type": "string", "description": description}
+	}
+
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"contentTitle":        field("Translated article title"),
+			"contentDescription":  field("Translated article summary"),
+			"metadataTitle":       field("Translated SEO title, maximum 120 characters"),
+			"metadataDescription": field("Translated SEO description, maximum 200 characters"),
+			"slug":                field("Localized URL slug: lowercase ASCII letters, digits and hyphens only"),
+		},
+		"required":             []string{"contentTitle", "contentDescription", "metadataTitle", "metadataDescription", "slug"},
+		"additionalProperties": false,
+	}
+
+	schemaBytes, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to convert schema to JSON: %v", err)
+	}
+
+	return schemaBytes, nil
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKey - api_keys tablosu (key_hash hiçbir zaman döndürülmez)
+type APIKey struct {
+	ID         uuid.UUID  `json:"id"`
+	UserID     uuid.UUID  `json:"userId"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expiresAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	LastUsedIP *string    `json:"lastUsedIp"`
+	RevokedAt  *time.Time `json:"revokedAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+// APIKeyAuth - doğrulanan API anahtarı ve sahibinin güncel bilgileri
+type APIKeyAuth struct {
+	Key  APIKey
+	User User
+}
+
+// APIKeyCreateInput - yeni API anahtarı oluşturma isteği
+type APIKeyCreateInput struct {
+	Name          string   `json:"name" binding:"required,min=1,max=100"`
+	Scopes        []string `json:"scopes" binding:"required,min=1,dive,required"`
+	ExpiresInDays *int     `json:"expiresInDays" binding:"omitempty,min=1,max=365"`
+}
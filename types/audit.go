@@ -0,0 +1,122 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditAction - kayıt altına alınan işlem türü
+type AuditAction string
+
+const (
+	// Blog işlemleri
+	AuditBlogCreate           AuditAction = "blog.create"
+	AuditBlogUpdate           AuditAction = "blog.update"
+	AuditBlogStatusUpdate     AuditAction = "blog.status_update"
+	AuditBlogSchedule         AuditAction = "blog.schedule"
+	AuditBlogScheduleCancel   AuditAction = "blog.schedule_cancel"
+	AuditBlogScheduledPublish AuditAction = "blog.scheduled_publish"
+	AuditBlogDelete           AuditAction = "blog.delete"
+	AuditBlogRestore          AuditAction = "blog.restore"
+	AuditBlogPurge            AuditAction = "blog.purge"
+	AuditBlogBulkUpdate       AuditAction = "blog.bulk_update"
+	AuditBlogGroupAttach      AuditAction = "blog.group_attach"
+	AuditBlogGroupDetach      AuditAction = "blog.group_detach"
+	AuditBlogRevisionRestore  AuditAction = "blog.revision_restore"
+	AuditBlogDraftPublish     AuditAction = "blog.draft_publish"
+	AuditBlogAITranslate      AuditAction = "blog.ai_translate"
+	AuditTagCreate            AuditAction = "taxonomy.tag_create"
+	AuditCategoryCreate       AuditAction = "taxonomy.category_create"
+
+	// Featured işlemleri
+	AuditFeaturedAdd     AuditAction = "featured.add"
+	AuditFeaturedRemove  AuditAction = "featured.remove"
+	AuditFeaturedReorder AuditAction = "featured.reorder"
+
+	// Görsel işlemleri
+	AuditImageUpload AuditAction = "image.upload"
+	AuditImageDelete AuditAction = "image.delete"
+
+	// Cache işlemleri
+	AuditCacheClear          AuditAction = "cache.clear"
+	AuditCacheClearPrefix    AuditAction = "cache.clear_prefix"
+	AuditRateLimitsClear     AuditAction = "cache.rate_limits_clear"
+	AuditRateLimitReset      AuditAction = "cache.rate_limit_reset"
+	AuditLoginLockoutClear   AuditAction = "auth.login_lockout_clear"
+	AuditTwoFactorPolicySave AuditAction = "auth.two_factor_policy_update"
+
+	// Kullanıcı işlemleri
+	AuditUserRoleUpdate     AuditAction = "user.role_update"
+	AuditUserStatusUpdate   AuditAction = "user.status_update"
+	AuditUserDelete         AuditAction = "user.delete"
+	AuditUserSessionsRevoke AuditAction = "user.sessions_revoke"
+	AuditUserSessionRevoke  AuditAction = "user.session_revoke"
+	AuditUserTwoFactorReset AuditAction = "user.two_factor_reset"
+	AuditPasswordChange     AuditAction = "user.password_change"
+	AuditPasswordReset      AuditAction = "user.password_reset"
+	AuditTwoFactorEnable    AuditAction = "user.two_factor_enable"
+	AuditTwoFactorDisable   AuditAction = "user.two_factor_disable"
+	AuditAPIKeyCreate       AuditAction = "user.api_key_create"
+	AuditAPIKeyRevoke       AuditAction = "user.api_key_revoke"
+)
+
+// AuditTargetType - işlemin uygulandığı kaynak türü
+type AuditTargetType string
+
+const (
+	AuditTargetBlog     AuditTargetType = "blog"
+	AuditTargetTag      AuditTargetType = "tag"
+	AuditTargetCategory AuditTargetType = "category"
+	AuditTargetFeatured AuditTargetType = "featured"
+	AuditTargetImage    AuditTargetType = "image"
+	AuditTargetCache    AuditTargetType = "cache"
+	AuditTargetUser     AuditTargetType = "user"
+	AuditTargetSession  AuditTargetType = "session"
+	AuditTargetAPIKey   AuditTargetType = "api_key"
+	AuditTargetPolicy   AuditTargetType = "policy"
+	AuditTargetLockout  AuditTargetType = "login_lockout"
+)
+
+// AuditEventInput - yeni audit kaydı
+type AuditEventInput struct {
+	ActorID       *uuid.UUID
+	ActorUsername string
+	Action        AuditAction
+	TargetType    AuditTargetType
+	TargetID      string
+	Before        json.RawMessage
+	After         json.RawMessage
+	IPAddress     string
+	UserAgent     string
+	AuthMethod    string
+}
+
+// AuditEvent - audit_events tablosu
+type AuditEvent struct {
+	ID            uuid.UUID       `json:"id"`
+	ActorID       *uuid.UUID      `json:"actorId"`
+	ActorUsername string          `json:"actorUsername"`
+	Action        AuditAction     `json:"action"`
+	TargetType    AuditTargetType `json:"targetType"`
+	TargetID      string          `json:"targetId"`
+	Before        json.RawMessage `json:"before"`
+	After         json.RawMessage `json:"after"`
+	IPAddress     string          `json:"ipAddress"`
+	UserAgent     string          `json:"userAgent"`
+	AuthMethod    string          `json:"authMethod"`
+	CreatedAt     time.Time       `json:"createdAt"`
+}
+
+// AuditQueryOptions - admin audit log filtreleri
+type AuditQueryOptions struct {
+	ActorID    *uuid.UUID
+	Action     string
+	TargetType string
+	TargetID   string
+	From       *time.Time
+	To         *time.Time
+	Limit      int
+	Offset     int
+}
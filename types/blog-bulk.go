@@ -0,0 +1,61 @@
+package types
+
+import (
+	"github.com/google/uuid"
+)
+
+// BlogBulkAction - toplu işlemde uygulanacak işlem türü
+type BlogBulkAction string
+
+const (
+	BlogBulkStatus           BlogBulkAction = "status"
+	BlogBulkAddTags          BlogBulkAction = "add_tags"
+	BlogBulkRemoveTags       BlogBulkAction = "remove_tags"
+	BlogBulkAddCategories    BlogBulkAction = "add_categories"
+	BlogBulkRemoveCategories BlogBulkAction = "remove_categories"
+	BlogBulkFeature          BlogBulkAction = "feature"
+	BlogBulkUnfeature        BlogBulkAction = "unfeature"
+	BlogBulkDelete           BlogBulkAction = "delete"
+)
+
+// BlogBulkInput - ID listesi veya blog kartı filtresiyle seçilen bloglara tek bir işlem uygular.
+// DryRun true ise işlem transaction içinde denenir ve geri alınır, yalnızca rapor döner.
+type BlogBulkInput struct {
+	Action     BlogBulkAction        `json:"action" binding:"required"`
+	IDs        []uuid.UUID           `json:"ids"`
+	Filter     *BlogCardQueryOptions `json:"filter"`
+	Status     BlogStatus            `json:"status"`
+	Tags       []string              `json:"tags"`
+	Categories []string              `json:"categories"`
+	DryRun     bool                  `json:"dryRun"`
+}
+
+// BlogBulkItemResult durumları
+const (
+	BlogBulkResultUpdated   = "updated"
+	BlogBulkResultUnchanged = "unchanged"
+	BlogBulkResultFailed    = "failed"
+)
+
+// BlogBulkItemResult - toplu işlemde tek bir blogun sonucu
+type BlogBulkItemResult struct {
+	ID       uuid.UUID  `json:"id"`
+	Slug     string     `json:"slug,omitempty"`
+	Language string     `json:"language,omitempty"`
+	Previous BlogStatus `json:"previousStatus,omitempty"`
+	Result   string     `json:"result"`
+	Error    string     `json:"error,omitempty"`
+	Message  string     `json:"message,omitempty"`
+}
+
+// BlogBulkReport - toplu işlemin özet raporu
+type BlogBulkReport struct {
+	Action    BlogBulkAction       `json:"action"`
+	DryRun    bool                 `json:"dryRun"`
+	Committed bool                 `json:"committed"`
+	Total     int                  `json:"total"`
+	Updated   int                  `json:"updated"`
+	Unchanged int                  `json:"unchanged"`
+	Failed    int                  `json:"failed"`
+	Items     []BlogBulkItemResult `json:"items"`
+}
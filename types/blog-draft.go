@@ -0,0 +1,28 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BlogDraftInput - otomatik kaydedilen çalışma kopyası (yayın durumu canlı blogdan yönetilir)
+type BlogDraftInput struct {
+	GroupID    string        `json:"groupId" binding:"required"`
+	Slug       string        `json:"slug" binding:"required"`
+	Language   string        `json:"language" binding:"required"`
+	Metadata   MetadataInput `json:"metadata" binding:"required"`
+	Content    ContentInput  `json:"content" binding:"required"`
+	Categories []string      `json:"categories"`
+	Tags       []string      `json:"tags"`
+}
+
+// BlogDraft - blog_drafts tablosundaki bir kayıt
+type BlogDraft struct {
+	BlogID    uuid.UUID      `json:"blogId"`
+	UserID    *uuid.UUID     `json:"userId,omitempty"`
+	Username  string         `json:"username,omitempty"`
+	Draft     BlogDraftInput `json:"draft"`
+	CreatedAt time.Time      `json:"createdAt"`
+	UpdatedAt time.Time      `json:"updatedAt"`
+}
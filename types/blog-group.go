@@ -0,0 +1,33 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BlogGroupMember - bir çeviri grubundaki tek bir dil versiyonu
+type BlogGroupMember struct {
+	ID          uuid.UUID  `json:"id"`
+	Slug        string     `json:"slug"`
+	Language    string     `json:"language"`
+	Status      BlogStatus `json:"status"`
+	Title       string     `json:"title"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+	PublishedAt *time.Time `json:"publishedAt,omitempty"`
+}
+
+// BlogGroupOverview - çeviri grubunun dil bazında özeti. MissingLanguages, blogda kullanılan
+// ancak bu grupta karşılığı olmayan dilleri listeler.
+type BlogGroupOverview struct {
+	GroupID          string            `json:"groupId"`
+	Posts            []BlogGroupMember `json:"posts"`
+	Languages        []string          `json:"languages"`
+	MissingLanguages []string          `json:"missingLanguages"`
+	LastUpdatedAt    *time.Time        `json:"lastUpdatedAt,omitempty"`
+}
+
+// BlogGroupAttachInput - blogu mevcut bir çeviri grubuna bağlama isteği
+type BlogGroupAttachInput struct {
+	GroupID string `json:"groupId" binding:"required"`
+}
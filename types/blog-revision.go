@@ -0,0 +1,74 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BlogRevision - blog_revisions tablosundaki bir kayıt (tam snapshot)
+type BlogRevision struct {
+	ID             uuid.UUID    `json:"id"`
+	BlogID         uuid.UUID    `json:"blogId"`
+	RevisionNumber int          `json:"revisionNumber"`
+	UserID         *uuid.UUID   `json:"userId,omitempty"`
+	Username       string       `json:"username,omitempty"`
+	GroupID        string       `json:"groupId"`
+	Slug           string       `json:"slug"`
+	Language       string       `json:"language"`
+	Status         BlogStatus   `json:"status"`
+	Metadata       MetadataView `json:"metadata"`
+	Content        ContentView  `json:"content"`
+	Categories     []string     `json:"categories"`
+	Tags           []string     `json:"tags"`
+	CreatedAt      time.Time    `json:"createdAt"`
+}
+
+// BlogRevisionSummary - revizyon listesi için hafif yapı (html/json içermez)
+type BlogRevisionSummary struct {
+	ID             uuid.UUID  `json:"id"`
+	RevisionNumber int        `json:"revisionNumber"`
+	UserID         *uuid.UUID `json:"userId,omitempty"`
+	Username       string     `json:"username,omitempty"`
+	Title          string     `json:"title"`
+	Slug           string     `json:"slug"`
+	Status         BlogStatus `json:"status"`
+	CreatedAt      time.Time  `json:"createdAt"`
+}
+
+// BlogRevisionFieldDiff - tek bir alanın iki revizyon arasındaki farkı
+type BlogRevisionFieldDiff struct {
+	Changed bool   `json:"changed"`
+	From    string `json:"from"`
+	To      string `json:"to"`
+}
+
+// BlogRevisionListDiff - kategori/etiket listeleri arasındaki fark
+type BlogRevisionListDiff struct {
+	Changed bool     `json:"changed"`
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+}
+
+// BlogRevisionHTMLDiff - HTML içeriği için satır bazlı fark
+type BlogRevisionHTMLDiff struct {
+	Changed bool       `json:"changed"`
+	Lines   []DiffLine `json:"lines"`
+}
+
+// DiffLine - satır bazlı farkın tek bir satırı ("equal", "added", "removed")
+type DiffLine struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// BlogRevisionDiff - iki revizyon arasındaki karşılaştırma sonucu
+type BlogRevisionDiff struct {
+	From        BlogRevisionSummary   `json:"from"`
+	To          BlogRevisionSummary   `json:"to"`
+	Title       BlogRevisionFieldDiff `json:"title"`
+	Description BlogRevisionFieldDiff `json:"description"`
+	HTML        BlogRevisionHTMLDiff  `json:"html"`
+	Categories  BlogRevisionListDiff  `json:"categories"`
+	Tags        BlogRevisionListDiff  `json:"tags"`
+}
@@ -0,0 +1,45 @@
+package types
+
+// BlogSearchOptions - /blog/search sorgu parametreleri
+type BlogSearchOptions struct {
+	Query    string `json:"query"`
+	Language string `json:"language"`
+	Category string `json:"category"`
+	Tag      string `json:"tag"`
+	Limit    int    `json:"limit"`
+	Offset   int    `json:"offset"`
+}
+
+// BlogSearchHighlights - eşleşen kelimeleri <mark> ile işaretlenmiş, HTML olarak güvenli parçalar
+type BlogSearchHighlights struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+// BlogSearchResult - arama sonucundaki tek bir blog kartı, sıralama puanı ve vurgulanmış parçaları
+type BlogSearchResult struct {
+	BlogPostCardView
+	Rank       float64              `json:"rank"`
+	Highlights BlogSearchHighlights `json:"highlights"`
+}
+
+// BlogSearchFacet - bir kategori, etiket veya dildeki eşleşen blog sayısı
+type BlogSearchFacet struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// BlogSearchFacets - arama sonuçlarının kategori, etiket ve dil dağılımı (sayfalamadan bağımsız)
+type BlogSearchFacets struct {
+	Categories []BlogSearchFacet `json:"categories"`
+	Tags       []BlogSearchFacet `json:"tags"`
+	Languages  []BlogSearchFacet `json:"languages"`
+}
+
+// BlogSearchPage - sayfalanmış arama sonuçları, toplam sayı ve facet'ler
+type BlogSearchPage struct {
+	Results []BlogSearchResult `json:"results"`
+	Total   int                `json:"total"`
+	Facets  BlogSearchFacets   `json:"facets"`
+}
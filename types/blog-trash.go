@@ -0,0 +1,31 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TrashedBlog - çöp kutusundaki (status = deleted) bir blog
+type TrashedBlog struct {
+	ID                 uuid.UUID  `json:"id"`
+	GroupID            string     `json:"groupId"`
+	Slug               string     `json:"slug"`
+	Language           string     `json:"language"`
+	Title              string     `json:"title"`
+	Image              string     `json:"image"`
+	StatusBeforeDelete BlogStatus `json:"statusBeforeDelete"`
+	DeletedAt          time.Time  `json:"deletedAt"`
+	DeletedBy          *uuid.UUID `json:"deletedBy,omitempty"`
+	DeletedByUsername  string     `json:"deletedByUsername,omitempty"`
+	PurgeAt            time.Time  `json:"purgeAt"`
+}
+
+// PurgedBlog - kalıcı olarak silinen blogun temizlik için gereken bilgileri
+type PurgedBlog struct {
+	ID       uuid.UUID
+	Slug     string
+	Language string
+	// Başka bir blog tarafından kullanılmayan ve R2'den silinebilecek görsel URL'leri
+	OrphanImageURLs []string
+}
@@ -14,6 +14,7 @@ const (
 	BlogStatusPublished BlogStatus = "published"
 	BlogStatusArchived  BlogStatus = "archived"
 	BlogStatusDeleted   BlogStatus = "deleted"
+	BlogStatusScheduled BlogStatus = "scheduled"
 )
 
 // ----- DATABASE TABLE STRUCTURES -----
@@ -29,6 +30,7 @@ type BlogPost struct {
 	CreatedAt   time.Time  `json:"createdAt" db:"created_at"`
 	UpdatedAt   time.Time  `json:"updatedAt" db:"updated_at"`
 	PublishedAt time.Time  `json:"publishedAt" db:"published_at"`
+	ScheduledAt *time.Time `json:"scheduledAt,omitempty" db:"scheduled_at"`
 }
 
 // BlogFeatured - featured blog ordering structure (YENİ)
@@ -85,9 +87,11 @@ type BlogPostView struct {
 	Stats       StatsView      `json:"stats"`
 	Categories  []CategoryView `json:"categories"`
 	Tags        []TagView      `json:"tags"`
+	TOC         []TOCItem      `json:"toc"`
 	CreatedAt   time.Time      `json:"createdAt"`
 	UpdatedAt   time.Time      `json:"updatedAt"`
 	PublishedAt time.Time      `json:"publishedAt"`
+	ScheduledAt *time.Time     `json:"scheduledAt,omitempty"`
 }
 
 // MetadataView - metadata view structure
@@ -99,12 +103,14 @@ type MetadataView struct {
 
 // ContentView - content view structure
 type ContentView struct {
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Image       string `json:"image"`
-	ReadTime    int    `json:"readTime"`
-	HTML        string `json:"html"`
-	JSON        string `json:"json"`
+	Title            string `json:"title"`
+	Description      string `json:"description"`
+	Image            string `json:"image"`
+	ReadTime         int    `json:"readTime"`
+	ReadTimeOverride bool   `json:"readTimeOverride"`
+	WordCount        int    `json:"wordCount"`
+	HTML             string `json:"html"`
+	JSON             string `json:"json"`
 }
 
 // BlogPostCardView - blog post list view structure
@@ -170,14 +176,15 @@ type TagView struct {
 
 // BlogPostCreateInput - blog post creation input (featured alanı kaldırıldı)
 type BlogPostCreateInput struct {
-	GroupID    string        `json:"groupId" binding:"required"`
-	Slug       string        `json:"slug" binding:"required"`
-	Language   string        `json:"language" binding:"required"`
-	Status     BlogStatus    `json:"status" binding:"required"`
-	Metadata   MetadataInput `json:"metadata" binding:"required"`
-	Content    ContentInput  `json:"content" binding:"required"`
-	Categories []string      `json:"categories"`
-	Tags       []string      `json:"tags"`
+	GroupID     string        `json:"groupId" binding:"required"`
+	Slug        string        `json:"slug" binding:"required"`
+	Language    string        `json:"language" binding:"required"`
+	Status      BlogStatus    `json:"status" binding:"required"`
+	ScheduledAt *time.Time    `json:"scheduledAt"` // Sadece status "scheduled" ise kullanılır
+	Metadata    MetadataInput `json:"metadata" binding:"required"`
+	Content     ContentInput  `json:"content" binding:"required"`
+	Categories  []string      `json:"categories"`
+	Tags        []string      `json:"tags"`
 }
 
 // MetadataInput - metadata input structure
@@ -189,12 +196,13 @@ type MetadataInput struct {
 
 // ContentInput - content input structure
 type ContentInput struct {
-	Title       string `json:"title" binding:"required"`
-	Description string `json:"description" binding:"required"`
-	Image       string `json:"image" binding:"required"`
-	ReadTime    int    `json:"readTime" binding:"required"`
-	HTML        string `json:"html" binding:"required"`
-	JSON        string `json:"json" binding:"required"`
+	Title            string `json:"title" binding:"required"`
+	Description      string `json:"description" binding:"required"`
+	Image            string `json:"image" binding:"required"`
+	ReadTime         int    `json:"readTime"` // Sunucuda hesaplanır, readTimeOverride true ise gönderilen değer kullanılır
+	ReadTimeOverride bool   `json:"readTimeOverride"`
+	HTML             string `json:"html"` // Sunucuda JSON'dan üretilir, gönderilen değer yok sayılır
+	JSON             string `json:"json" binding:"required"`
 }
 
 // CategoryInput - category creation input
@@ -216,20 +224,46 @@ type BlogSelectByGroupIDInput struct {
 
 // ----- SELECT STRUCTURES -----
 type BlogCardQueryOptions struct {
-	ID            uuid.UUID     `json:"id"`
-	IDs           []uuid.UUID   `json:"ids"`
-	CategoryValue string        `json:"categoryValue"`
-	TagValue      string        `json:"tagValue"`
-	Title         string        `json:"title"`
-	Language      string        `json:"language"`
-	Featured      bool          `json:"featured"` // Blog_featured tablosundan kontrol edilecek
-	Status        BlogStatus    `json:"status"`
-	Limit         int           `json:"limit"`
-	Offset        int           `json:"offset"`
-	StartDate     *time.Time    `json:"startDate"`
-	EndDate       *time.Time    `json:"endDate"`
-	SortBy        string        `json:"sortBy"`
-	SortDirection SortDirection `json:"sortDirection"`
+	ID                    uuid.UUID     `json:"id"`
+	IDs                   []uuid.UUID   `json:"ids"`
+	CategoryValue         string        `json:"categoryValue"`
+	TagValue              string        `json:"tagValue"`
+	CategoryValues        []string      `json:"categoryValues"`        // Kategorilerden herhangi birine sahip
+	TagValues             []string      `json:"tagValues"`             // Etiketlerin tümüne sahip
+	ExcludeCategoryValues []string      `json:"excludeCategoryValues"` // Bu kategorilerin hiçbirinde olmayan
+	AuthorID              *uuid.UUID    `json:"authorId"`
+	MinReadTime           *int          `json:"minReadTime"` // Dakika, dahil
+	MaxReadTime           *int          `json:"maxReadTime"` // Dakika, dahil
+	Title                 string        `json:"title"`
+	Language              string        `json:"language"`
+	Featured              bool          `json:"featured"` // Blog_featured tablosundan kontrol edilecek
+	Status                BlogStatus    `json:"status"`
+	Limit                 int           `json:"limit"`
+	Offset                int           `json:"offset"`
+	StartDate             *time.Time    `json:"startDate"`
+	EndDate               *time.Time    `json:"endDate"`
+	SortBy                string        `json:"sortBy"`
+	SortDirection         SortDirection `json:"sortDirection"`
+	// Cursor verilirse Offset yok sayılır ve keyset sayfalama kullanılır
+	Cursor *BlogCardCursor `json:"-"`
+}
+
+// BlogCardCursor - keyset sayfalama konumu. İstemciye imzalı ve opak olarak verilir (utils.EncodeBlogCardCursor).
+// Key, son/ilk kartın sıralama değerinin metin hali; ID aynı değerdeki kartları ayırır.
+type BlogCardCursor struct {
+	SortBy        string        `json:"s"`
+	SortDirection SortDirection `json:"d"`
+	Key           string        `json:"k"`
+	ID            string        `json:"i"`
+	Backward      bool          `json:"b,omitempty"` // true ise konumdan önceki sayfa istenir
+}
+
+// BlogCardPage - blog kartları, toplam sayı ve sonraki/önceki sayfa cursor'ları
+type BlogCardPage struct {
+	Blogs      []BlogPostCardView `json:"blogs"`
+	Total      int                `json:"total"`
+	NextCursor string             `json:"nextCursor"`
+	PrevCursor string             `json:"prevCursor"`
 }
 
 type SortDirection string
@@ -242,15 +276,16 @@ const (
 // ----- UPDATE STRUCTURES -----
 
 type BlogUpdateInput struct {
-	ID         string        `json:"id" binding:"required"`
-	GroupID    string        `json:"groupId" binding:"required"`
-	Slug       string        `json:"slug" binding:"required"`
-	Language   string        `json:"language" binding:"required"`
-	Status     BlogStatus    `json:"status"`
-	Metadata   MetadataInput `json:"metadata" binding:"required"`
-	Content    ContentInput  `json:"content" binding:"required"`
-	Categories []string      `json:"categories"`
-	Tags       []string      `json:"tags"`
+	ID          string        `json:"id" binding:"required"`
+	GroupID     string        `json:"groupId" binding:"required"`
+	Slug        string        `json:"slug" binding:"required"`
+	Language    string        `json:"language" binding:"required"`
+	Status      BlogStatus    `json:"status"`
+	ScheduledAt *time.Time    `json:"scheduledAt"` // Sadece status "scheduled" ise kullanılır
+	Metadata    MetadataInput `json:"metadata" binding:"required"`
+	Content     ContentInput  `json:"content" binding:"required"`
+	Categories  []string      `json:"categories"`
+	Tags        []string      `json:"tags"`
 }
 
 type BlogUpdateStatusInput struct {
@@ -258,6 +293,12 @@ type BlogUpdateStatusInput struct {
 	Status BlogStatus `json:"status" binding:"required"`
 }
 
+// BlogScheduleInput - blogu ileri bir tarihte yayınlanmak üzere zamanlama / yeniden zamanlama
+type BlogScheduleInput struct {
+	ID          string    `json:"id" binding:"required"`
+	ScheduledAt time.Time `json:"scheduledAt" binding:"required"`
+}
+
 // ----- FEATURED BLOG STRUCTURES (YENİ) -----
 
 // FeaturedBlogInput - featured blog ekleme/çıkarma için input
@@ -271,3 +312,25 @@ type FeaturedBlogOrderingInput struct {
 	Language string      `json:"language" binding:"required"`
 	BlogIDs  []uuid.UUID `json:"blogIds" binding:"required"`
 }
+
+// TOCItem - içindekiler tablosundaki bir başlık (ID, HTML'deki başlığın id özniteliğidir)
+type TOCItem struct {
+	ID    string `json:"id"`
+	Text  string `json:"text"`
+	Level int    `json:"level"`
+}
+
+// ContentStats - içerik JSON'ından hesaplanan kelime sayısı, okuma süresi ve içindekiler
+type ContentStats struct {
+	WordCount int
+	ReadTime  int
+	TOC       []TOCItem
+}
+
+// BlogContentBackfillRow - mevcut içeriklerin toplu güncellenmesinde okunan blog_content satırı
+type BlogContentBackfillRow struct {
+	ID       uuid.UUID
+	Language string
+	HTML     string
+	JSON     string
+}
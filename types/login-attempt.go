@@ -0,0 +1,26 @@
+package types
+
+import "time"
+
+// LoginAttemptScope başarısız giriş sayacının hangi anahtara göre tutulduğunu belirtir
+type LoginAttemptScope string
+
+const (
+	LoginAttemptScopeUsername LoginAttemptScope = "username"
+	LoginAttemptScopeIP       LoginAttemptScope = "ip"
+)
+
+// LoginAttemptInfo bir kullanıcı adı veya IP için başarısız giriş bilgilerini tutar
+type LoginAttemptInfo struct {
+	Scope        LoginAttemptScope `json:"scope"`
+	Identifier   string            `json:"identifier"`
+	Failures     int               `json:"failures"`     // Pencere içindeki başarısız deneme sayısı
+	FirstFailure time.Time         `json:"firstFailure"` // Pencere içindeki ilk başarısız deneme
+	LastFailure  time.Time         `json:"lastFailure"`  // Son başarısız deneme
+	LockedUntil  time.Time         `json:"lockedUntil"`  // Kilidin açılacağı zaman (kilit yoksa sıfır)
+}
+
+// IsLocked kaydın verilen anda kilitli olup olmadığını döndürür
+func (i LoginAttemptInfo) IsLocked(now time.Time) bool {
+	return i.LockedUntil.After(now)
+}
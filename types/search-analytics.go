@@ -0,0 +1,65 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SearchQuerySource - aramanın yapıldığı endpoint
+type SearchQuerySource string
+
+const (
+	SearchSourceSearch SearchQuerySource = "search" // /blog/search
+	SearchSourceCards  SearchQuerySource = "cards"  // /blog/cards?title=
+)
+
+// SearchQueryEvent - search_queries tablosuna yazılacak tek bir arama
+type SearchQueryEvent struct {
+	ID          uuid.UUID
+	Query       string
+	Language    string
+	Source      SearchQuerySource
+	ResultCount int
+	CreatedAt   time.Time
+}
+
+// SearchClickEvent - bir arama sonucundaki bloga tıklanması
+type SearchClickEvent struct {
+	SearchID  uuid.UUID
+	BlogID    uuid.UUID
+	ClickedAt time.Time
+}
+
+// SearchClickInput - POST /blog/search/click
+type SearchClickInput struct {
+	SearchID uuid.UUID `json:"searchId" binding:"required"`
+	BlogID   uuid.UUID `json:"blogId" binding:"required"`
+}
+
+// SearchAnalyticsOptions - admin arama raporu filtreleri
+type SearchAnalyticsOptions struct {
+	From     time.Time
+	To       time.Time
+	Language string
+	Limit    int
+}
+
+// SearchQueryStat - bir sorgunun zaman aralığındaki özeti
+type SearchQueryStat struct {
+	Query            string    `json:"query"`
+	Searches         int       `json:"searches"`
+	ZeroResults      int       `json:"zeroResults"`
+	Clicks           int       `json:"clicks"`
+	ClickThroughRate float64   `json:"clickThroughRate"`
+	AvgResults       float64   `json:"avgResults"`
+	LastSearchedAt   time.Time `json:"lastSearchedAt"`
+}
+
+// TrendingSearchQuery - aramaları bir önceki eşit uzunluktaki aralığa göre artan sorgu
+type TrendingSearchQuery struct {
+	Query            string  `json:"query"`
+	Searches         int     `json:"searches"`
+	PreviousSearches int     `json:"previousSearches"`
+	Growth           float64 `json:"growth"` // searches / max(previousSearches, 1)
+}
@@ -8,18 +8,24 @@ import (
 
 // Table Model (database/migrations/00001.auth.up.sql)
 type RefreshToken struct {
-	ID            uuid.UUID `db:"id" json:"id"`
-	UserID        uuid.UUID `db:"user_id" json:"userId"`
-	UserEmail     string    `db:"user_email" json:"userEmail"`
-	UserUsername  string    `db:"user_username" json:"userUsername"`
-	Token         string    `db:"token" json:"token"`
-	IPAddress     string    `db:"ip_address" json:"ipAddress"`
-	UserAgent     string    `db:"user_agent" json:"userAgent"`
-	ExpiresAt     time.Time `db:"expires_at" json:"expiresAt"`
-	CreatedAt     time.Time `db:"created_at" json:"createdAt"`
-	LastUsedAt    time.Time `db:"last_used_at" json:"lastUsedAt"`
-	IsRevoked     bool      `db:"is_revoked" json:"isRevoked"`
-	RevokedReason string    `db:"revoked_reason,omitempty" json:"revokedReason,omitempty"`
+	ID            uuid.UUID  `db:"id" json:"id"`
+	UserID        uuid.UUID  `db:"user_id" json:"userId"`
+	UserEmail     string     `db:"user_email" json:"userEmail"`
+	UserUsername  string     `db:"user_username" json:"userUsername"`
+	Token         string     `db:"token" json:"token"`
+	IPAddress     string     `db:"ip_address" json:"ipAddress"`
+	UserAgent     string     `db:"user_agent" json:"userAgent"`
+	ExpiresAt     time.Time  `db:"expires_at" json:"expiresAt"`
+	CreatedAt     time.Time  `db:"created_at" json:"createdAt"`
+	LastUsedAt    time.Time  `db:"last_used_at" json:"lastUsedAt"`
+	IsRevoked     bool       `db:"is_revoked" json:"isRevoked"`
+	RevokedReason string     `db:"revoked_reason,omitempty" json:"revokedReason,omitempty"`
+	FamilyID      uuid.UUID  `db:"family_id" json:"familyId"`
+	ParentID      *uuid.UUID `db:"parent_id" json:"parentId,omitempty"`
+	RotatedAt     *time.Time `db:"rotated_at" json:"rotatedAt,omitempty"`
+	ReplacedBy    *uuid.UUID `db:"replaced_by" json:"replacedBy,omitempty"`
+	// Oturumun (family) ilk giriş zamanı; rotasyonlarda değişmez
+	SessionStartedAt time.Time `db:"session_started_at" json:"sessionStartedAt"`
 }
 
 type TokenCreateRequest struct {
@@ -42,4 +48,45 @@ type TokenClaims struct {
 	Status        UserStatus `json:"status"`
 	CreatedAt     time.Time  `json:"createdAt"`
 	LastLogin     time.Time  `json:"lastLogin"`
+	// Token'ın oluşturulma zamanı; JWT'nin "iat" alanından doğrulama sırasında doldurulur
+	TokenIssuedAt time.Time `json:"-"`
+}
+
+// SessionView - kullanıcıya gösterilen oturum bilgisi (token değeri dışarı verilmez).
+// ID, rotasyonlarda değişmeyen family_id'dir; CreatedAt oturumun açıldığı zamandır.
+type SessionView struct {
+	ID         uuid.UUID `json:"id"`
+	IPAddress  string    `json:"ipAddress"`
+	UserAgent  string    `json:"userAgent"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastUsedAt time.Time `json:"lastUsedAt"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+	Current    bool      `json:"current"`
+}
+
+// NewSessionViews refresh tokenları oturum listesine çevirir, çerezdeki token mevcut oturum olarak işaretlenir
+func NewSessionViews(tokens []RefreshToken, currentToken string) []SessionView {
+	sessions := make([]SessionView, 0, len(tokens))
+	for _, token := range tokens {
+		sessions = append(sessions, SessionView{
+			ID:         token.FamilyID,
+			IPAddress:  token.IPAddress,
+			UserAgent:  token.UserAgent,
+			CreatedAt:  token.SessionStartedAt,
+			LastUsedAt: token.LastUsedAt,
+			ExpiresAt:  token.ExpiresAt,
+			Current:    currentToken != "" && token.Token == currentToken,
+		})
+	}
+	return sessions
+}
+
+// TokenReuseEvent - rotate edilmiş bir refresh tokenın tekrar kullanıldığı olay
+type TokenReuseEvent struct {
+	ID        uuid.UUID `json:"id"`
+	FamilyID  uuid.UUID `json:"familyId"`
+	TokenID   uuid.UUID `json:"tokenId"`
+	IPAddress string    `json:"ipAddress"`
+	UserAgent string    `json:"userAgent"`
+	CreatedAt time.Time `json:"createdAt"`
 }
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Table Model (database/migrations/000012_two_factor_auth.up.sql)
+type UserTwoFactor struct {
+	UserID       uuid.UUID  `db:"user_id" json:"userId"`
+	Secret       string     `db:"secret" json:"-"`
+	Enabled      bool       `db:"enabled" json:"enabled"`
+	ConfirmedAt  *time.Time `db:"confirmed_at" json:"confirmedAt"`
+	LastUsedStep int64      `db:"last_used_step" json:"-"`
+	CreatedAt    time.Time  `db:"created_at" json:"createdAt"`
+	UpdatedAt    time.Time  `db:"updated_at" json:"updatedAt"`
+}
+
+// TwoFactorPolicy - rol bazında 2FA zorunluluğu
+type TwoFactorPolicy struct {
+	Role      Role       `json:"role"`
+	Required  bool       `json:"required"`
+	UpdatedBy *uuid.UUID `json:"updatedBy"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+}
+
+// TwoFactorStatus - kullanıcının 2FA durumu
+type TwoFactorStatus struct {
+	Enabled                bool       `json:"enabled"`
+	Required               bool       `json:"required"`
+	ConfirmedAt            *time.Time `json:"confirmedAt"`
+	RemainingRecoveryCodes int        `json:"remainingRecoveryCodes"`
+}
+
+// TwoFactorChallenge - şifresi doğrulanmış, ikinci adımı bekleyen giriş denemesi (cache'de tutulur)
+type TwoFactorChallenge struct {
+	UserID    uuid.UUID `json:"userId"`
+	Attempts  int       `json:"attempts"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// TwoFactorCodeRequest - TOTP kodu (veya yeniden oluşturmada kurtarma kodu) içeren istek
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" binding:"required,min=6,max=16"`
+}
+
+// TwoFactorDisableRequest - 2FA kapatma isteği
+type TwoFactorDisableRequest struct {
+	Password string `json:"password" binding:"required"`
+	Code     string `json:"code" binding:"required,min=6,max=16"`
+}
+
+// TwoFactorLoginRequest - girişin ikinci adımı; TOTP kodu veya kurtarma kodu gönderilir
+type TwoFactorLoginRequest struct {
+	ChallengeToken string `json:"challengeToken" binding:"required"`
+	Code           string `json:"code" binding:"omitempty,min=6,max=10"`
+	RecoveryCode   string `json:"recoveryCode" binding:"omitempty,min=10,max=16"`
+}
+
+// TwoFactorSetupLoginRequest - 2FA zorunlu olup kurulumu yapılmamış hesaplar için giriş sırasında kurulum
+type TwoFactorSetupLoginRequest struct {
+	ChallengeToken string `json:"challengeToken" binding:"required"`
+}
+
+// TwoFactorPolicyUpdateInput - admin rol politikası güncelleme isteği
+type TwoFactorPolicyUpdateInput struct {
+	Required *bool `json:"required" binding:"required"`
+}
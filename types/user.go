@@ -63,3 +63,44 @@ type UserLoginRequest struct {
 	Username string `json:"username" binding:"required"`
 	Password string `json:"password" binding:"required"`
 }
+
+// UserQueryOptions - admin kullanıcı listesi filtreleri
+type UserQueryOptions struct {
+	Search string
+	Role   Role
+	Status UserStatus
+	Limit  int
+	Offset int
+}
+
+// UserRoleUpdateInput - admin rol değiştirme isteği
+type UserRoleUpdateInput struct {
+	Role Role `json:"role" binding:"required,oneof=User Editor Admin"`
+}
+
+// UserStatusUpdateInput - admin askıya alma / yeniden aktifleştirme isteği
+type UserStatusUpdateInput struct {
+	Status UserStatus `json:"status" binding:"required,oneof=Active Suspended"`
+}
+
+// ChangePasswordRequest - oturum açmış kullanıcının şifre değiştirme isteği
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"currentPassword" binding:"required"`
+	NewPassword     string `json:"newPassword" binding:"required,min=6"`
+}
+
+// ForgotPasswordRequest - şifre sıfırlama e-postası isteği
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+// ResetPasswordRequest - e-postadaki token ile yeni şifre belirleme isteği
+type ResetPasswordRequest struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"newPassword" binding:"required,min=6"`
+}
+
+// VerifyEmailRequest - e-posta doğrulama isteği
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
@@ -0,0 +1,24 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+)
+
+// GenerateAPIKey prefix'li yeni bir API anahtarı ve listelemede gösterilecek kısa önekini üretir
+func GenerateAPIKey() (string, string) {
+	key := configs.API_KEY_PREFIX + GenerateRandomString(configs.API_KEY_LENGTH)
+	return key, key[:len(configs.API_KEY_PREFIX)+8]
+}
+
+// BearerToken Authorization başlığındaki Bearer değerini döndürür
+func BearerToken(header string) (string, bool) {
+	scheme, token, found := strings.Cut(strings.TrimSpace(header), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
@@ -0,0 +1,124 @@
+package utils
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+	"github.com/okanay/backend-blog-guideofdubai/types"
+)
+
+// İçerik kurallarının doğrulama etiketleri (FieldError.Rule)
+const (
+	contentRuleMaxSize      = "content_max_size"
+	contentRuleDocument     = "content_document"
+	contentRuleImageAlt     = "image_alt"
+	contentRuleImageSource  = "image_source"
+	contentRuleEmptyHeading = "empty_heading"
+)
+
+// registerContentValidations blog oluşturma/güncelleme isteklerine içerik kurallarını ekler.
+// Taslaklar otomatik kaydedildiği için yalnızca boyut sınırına tabidir.
+func registerContentValidations(engine *validator.Validate) {
+	engine.RegisterStructValidation(func(sl validator.StructLevel) {
+		validateBlogContent(sl, sl.Current().Interface().(types.BlogPostCreateInput).Content, true)
+	}, types.BlogPostCreateInput{})
+
+	engine.RegisterStructValidation(func(sl validator.StructLevel) {
+		validateBlogContent(sl, sl.Current().Interface().(types.BlogUpdateInput).Content, true)
+	}, types.BlogUpdateInput{})
+
+	engine.RegisterStructValidation(func(sl validator.StructLevel) {
+		validateBlogContent(sl, sl.Current().Interface().(types.BlogDraftInput).Content, false)
+	}, types.BlogDraftInput{})
+}
+
+func validateBlogContent(sl validator.StructLevel, content types.ContentInput, strict bool) {
+	if len(content.JSON) > configs.BLOG_CONTENT_MAX_BYTES {
+		sl.ReportError(content.JSON, "content.json", "JSON", contentRuleMaxSize, strconv.Itoa(configs.BLOG_CONTENT_MAX_BYTES))
+		return
+	}
+
+	if !strict || content.JSON == "" {
+		return
+	}
+
+	doc, err := ParseTipTapJSON(content.JSON)
+	if err != nil || doc.Type != "doc" {
+		sl.ReportError(content.JSON, "content.json", "JSON", contentRuleDocument, "")
+		return
+	}
+
+	publicBase := strings.TrimSpace(os.Getenv("R2_PUBLIC_URL_BASE"))
+	if content.Image != "" && !isPublicAssetURL(content.Image, publicBase) {
+		sl.ReportError(content.Image, "content.image", "Image", contentRuleImageSource, content.Image)
+	}
+
+	headingIndex := 0
+	walkTipTapNodes(*doc, func(node TipTapNode) {
+		switch node.Type {
+		case "heading":
+			headingIndex++
+			if strings.TrimSpace(tipTapPlainText(node)) == "" {
+				sl.ReportError(content.JSON, "content.json", "JSON", contentRuleEmptyHeading, strconv.Itoa(headingIndex))
+			}
+
+		case "image", "enhancedImage":
+			validateContentImage(sl, content.JSON, node.Attrs, publicBase)
+
+		case "instagramCarousel":
+			if cards, ok := node.Attrs["cards"].([]any); ok {
+				for _, card := range cards {
+					if attrs, ok := card.(map[string]any); ok {
+						validateContentImage(sl, content.JSON, attrs, publicBase)
+					}
+				}
+			}
+		}
+	})
+}
+
+func validateContentImage(sl validator.StructLevel, value string, attrs map[string]any, publicBase string) {
+	src := strings.TrimSpace(tipTapString(attrs, "src"))
+
+	if strings.TrimSpace(tipTapString(attrs, "alt")) == "" {
+		sl.ReportError(value, "content.json", "JSON", contentRuleImageAlt, src)
+	}
+
+	if !isPublicAssetURL(src, publicBase) {
+		sl.ReportError(value, "content.json", "JSON", contentRuleImageSource, src)
+	}
+}
+
+// isPublicAssetURL URL'in R2 public base altında olup olmadığını kontrol eder.
+// Base tanımlı değilse (ör. yerel geliştirme) yalnızca boş olmaması beklenir.
+func isPublicAssetURL(src string, publicBase string) bool {
+	if src == "" {
+		return false
+	}
+	if publicBase == "" {
+		return true
+	}
+	return strings.HasPrefix(src, strings.TrimSuffix(publicBase, "/")+"/")
+}
+
+// walkTipTapNodes düğüm ağacını derinlik öncelikli dolaşır
+func walkTipTapNodes(node TipTapNode, visit func(TipTapNode)) {
+	visit(node)
+	for _, child := range node.Content {
+		walkTipTapNodes(child, visit)
+	}
+}
+
+// tipTapPlainText düğümün altındaki tüm metinleri birleştirir
+func tipTapPlainText(node TipTapNode) string {
+	var sb strings.Builder
+	walkTipTapNodes(node, func(child TipTapNode) {
+		if child.Type == "text" {
+			sb.WriteString(child.Text)
+		}
+	})
+	return sb.String()
+}
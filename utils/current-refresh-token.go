@@ -0,0 +1,21 @@
+package utils
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-blog-guideofdubai/configs"
+)
+
+// CurrentRefreshToken isteğe ait güncel refresh tokenı döndürür.
+// Token aynı istek içinde yenilendiyse (rotation) çerezdeki eski değer yerine yenisi kullanılır.
+func CurrentRefreshToken(c *gin.Context) (string, bool) {
+	if token := c.GetString("refresh_token"); token != "" {
+		return token, true
+	}
+
+	token, err := c.Cookie(configs.REFRESH_TOKEN_NAME)
+	if err != nil || token == "" {
+		return "", false
+	}
+
+	return token, true
+}
@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/okanay/backend-blog-guideofdubai/types"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// EncodeBlogCardCursor cursor'ı "payload.imza" biçiminde base64url olarak kodlar.
+// İmza, istemcinin sıralama değerini veya ID'yi değiştirmesini engeller.
+func EncodeBlogCardCursor(cursor types.BlogCardCursor) (string, error) {
+	secret, err := cursorSecret()
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + signCursor(encoded, secret), nil
+}
+
+// DecodeBlogCardCursor imzayı doğrular ve cursor'ı çözer; geçersiz her durumda ErrInvalidCursor döner
+func DecodeBlogCardCursor(value string) (*types.BlogCardCursor, error) {
+	secret, err := cursorSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	encoded, signature, found := strings.Cut(value, ".")
+	if !found || !hmac.Equal([]byte(signature), []byte(signCursor(encoded, secret))) {
+		return nil, ErrInvalidCursor
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor types.BlogCardCursor
+	if err := json.Unmarshal(payload, &cursor); err != nil || cursor.ID == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	return &cursor, nil
+}
+
+func signCursor(encoded string, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(encoded))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// cursorSecret CURSOR_SECRET yoksa JWT_ACCESS_SECRET kullanılır
+func cursorSecret() (string, error) {
+	if secret := os.Getenv("CURSOR_SECRET"); secret != "" {
+		return secret, nil
+	}
+	if secret := os.Getenv("JWT_ACCESS_SECRET"); secret != "" {
+		return secret, nil
+	}
+	return "", errors.New("CURSOR_SECRET environment variable is not set")
+}
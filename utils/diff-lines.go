@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/okanay/backend-blog-guideofdubai/types"
+)
+
+// LCS tablosunun bellek kullanımını sınırlamak için satır limiti
+const maxDiffLines = 2000
+
+// DiffLines iki metni satır bazında karşılaştırır (LCS tabanlı)
+func DiffLines(from, to string) []types.DiffLine {
+	a := splitLines(from)
+	b := splitLines(to)
+
+	// Çok büyük içeriklerde satır satır karşılaştırma yerine tam değişim döndür
+	if len(a) > maxDiffLines || len(b) > maxDiffLines {
+		lines := make([]types.DiffLine, 0, len(a)+len(b))
+		for _, line := range a {
+			lines = append(lines, types.DiffLine{Type: "removed", Text: line})
+		}
+		for _, line := range b {
+			lines = append(lines, types.DiffLine{Type: "added", Text: line})
+		}
+		return lines
+	}
+
+	// lcs[i][j] = a[i:] ve b[j:] için en uzun ortak alt dizi uzunluğu
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	lines := make([]types.DiffLine, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, types.DiffLine{Type: "equal", Text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, types.DiffLine{Type: "removed", Text: a[i]})
+			i++
+		default:
+			lines = append(lines, types.DiffLine{Type: "added", Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, types.DiffLine{Type: "removed", Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, types.DiffLine{Type: "added", Text: b[j]})
+	}
+
+	return lines
+}
+
+// splitLines HTML içeriğini karşılaştırılabilir satırlara böler.
+// Editör HTML'i tek satır halinde ürettiği için etiket sınırlarından da bölünür.
+func splitLines(content string) []string {
+	if content == "" {
+		return []string{}
+	}
+
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	content = strings.ReplaceAll(content, "><", ">\n<")
+
+	var lines []string
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines
+}
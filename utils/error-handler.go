@@ -11,8 +11,16 @@ import (
 
 // ErrorResponse represents a standard error response format
 type ErrorResponse struct {
-	Success bool   `json:"success"`
-	Error   string `json:"error"`
+	Success bool         `json:"success"`
+	Error   string       `json:"error"`
+	Message string       `json:"message"`
+	Fields  []FieldError `json:"fields,omitempty"`
+}
+
+// FieldError doğrulama hatasının hangi alanda ve hangi kural yüzünden oluştuğunu belirtir
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
 	Message string `json:"message"`
 }
 
@@ -92,6 +100,12 @@ func HandleDatabaseError(c *gin.Context, err error, operation string) bool {
 				ErrorCode:     "slug_language_exists",
 				Message:       "Bu url yapısı ve dil kombinasyonu zaten kullanımda.",
 			},
+			{
+				Code:          "23505",
+				ConstraintKey: "blog_posts_group_language_key",
+				ErrorCode:     "group_language_exists",
+				Message:       "Bu çeviri grubunda aynı dilde başka bir blog yazısı zaten var.",
+			},
 			{
 				Code:          "23505",
 				ConstraintKey: "categories_name_key",
@@ -0,0 +1,13 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// HashToken veritabanında saklanacak tek kullanımlık tokenların SHA-256 özetini döndürür.
+// Tokenlar yüksek entropili olduğundan bcrypt yerine hızlı ve aranabilir bir hash yeterlidir.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -83,6 +83,9 @@ func ValidateAccessToken(tokenString string) (*types.TokenClaims, error) {
 	}
 
 	// Token geçerli, claims'i döndür
+	if claims.IssuedAt != nil {
+		claims.TokenIssuedAt = claims.IssuedAt.Time
+	}
 	return &claims.TokenClaims, nil
 }
 
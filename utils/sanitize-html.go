@@ -0,0 +1,166 @@
+package utils
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// sanitizeAllowedAttrs izin verilen etiketler ve her etikette izin verilen öznitelikler
+var sanitizeAllowedAttrs = map[string][]string{
+	"p": {"style"}, "h1": {"style", "id"}, "h2": {"style", "id"}, "h3": {"style", "id"},
+	"h4": {"style", "id"}, "h5": {"style", "id"}, "h6": {"style", "id"},
+	"blockquote": nil, "ul": {"data-type"}, "ol": {"start"}, "li": {"data-type"},
+	"pre": nil, "code": {"class"}, "br": nil, "hr": nil,
+	"strong": nil, "b": nil, "em": nil, "i": nil, "u": nil, "s": nil, "mark": nil, "sub": nil, "sup": nil,
+	"a":      {"href", "target", "rel"},
+	"img":    {"src", "alt", "title", "width", "height", "loading"},
+	"figure": nil, "figcaption": nil, "div": {"class", "data-youtube-video"}, "span": nil,
+	"table": nil, "thead": nil, "tbody": nil, "tr": nil, "td": {"colspan", "rowspan"}, "th": {"colspan", "rowspan"},
+	"input":  {"type", "checked", "disabled"},
+	"iframe": {"src", "width", "height", "frameborder", "allowfullscreen", "loading"},
+}
+
+// sanitizeDroppedContent içeriğiyle birlikte tamamen atılan etiketler
+var sanitizeDroppedContent = map[string]bool{
+	"script": true, "style": true, "noscript": true, "template": true, "object": true, "embed": true, "svg": true, "math": true,
+}
+
+var (
+	sanitizeStylePattern = regexp.MustCompile(`^text-align:\s*(left|center|right|justify);?$`)
+	sanitizeClassPattern = regexp.MustCompile(`^[a-zA-Z0-9_ -]*$`)
+)
+
+const youtubeEmbedBase = "https://www.youtube-nocookie.com/embed/"
+
+// SanitizeHTML HTML'i izin listesine göre temizler. Script/style gibi etiketler içerikleriyle atılır,
+// olay öznitelikleri (on*) ve güvensiz URL'ler (javascript: vb.) kaldırılır, bilinmeyen etiketlerin yalnızca metni kalır.
+func SanitizeHTML(input string) string {
+	tokenizer := html.NewTokenizer(strings.NewReader(input))
+	var sb strings.Builder
+	dropDepth := 0
+	// Reddedilen iframe'lerin kapanış etiketleri de yazılmaz
+	rejectedIframes := 0
+
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			// io.EOF veya bozuk girdi: o ana kadar temizlenen çıktı döndürülür
+			return sb.String()
+		}
+
+		token := tokenizer.Token()
+
+		switch tokenType {
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if sanitizeDroppedContent[token.Data] {
+				if tokenType == html.StartTagToken {
+					dropDepth++
+				}
+				continue
+			}
+			if dropDepth > 0 {
+				continue
+			}
+			if sanitized, ok := sanitizeTag(token); ok {
+				sb.WriteString(sanitized.String())
+			} else if token.Data == "iframe" && tokenType == html.StartTagToken {
+				rejectedIframes++
+			}
+
+		case html.EndTagToken:
+			if sanitizeDroppedContent[token.Data] {
+				if dropDepth > 0 {
+					dropDepth--
+				}
+				continue
+			}
+			if dropDepth > 0 {
+				continue
+			}
+			if token.Data == "iframe" && rejectedIframes > 0 {
+				rejectedIframes--
+				continue
+			}
+			if _, ok := sanitizeAllowedAttrs[token.Data]; ok {
+				sb.WriteString(token.String())
+			}
+
+		case html.TextToken:
+			if dropDepth == 0 {
+				sb.WriteString(token.String())
+			}
+		}
+		// Yorumlar ve doctype yazılmaz
+	}
+}
+
+// sanitizeTag etiketi izin listesine göre filtreler; etiket atılacaksa false döner
+func sanitizeTag(token html.Token) (html.Token, bool) {
+	allowed, ok := sanitizeAllowedAttrs[token.Data]
+	if !ok {
+		return token, false
+	}
+
+	attrs := make([]html.Attribute, 0, len(token.Attr))
+	for _, attr := range token.Attr {
+		key := strings.ToLower(attr.Key)
+		if attr.Namespace != "" || !slices.Contains(allowed, key) {
+			continue
+		}
+
+		value, keep := sanitizeAttrValue(token.Data, key, attr.Val)
+		if keep {
+			attrs = append(attrs, html.Attribute{Key: key, Val: value})
+		}
+	}
+	token.Attr = attrs
+
+	switch token.Data {
+	case "iframe":
+		// Yalnızca renderer'ın ürettiği YouTube embed'leri kabul edilir
+		if !hasAttr(attrs, "src") {
+			return token, false
+		}
+	case "input":
+		for _, attr := range attrs {
+			if attr.Key == "type" && attr.Val != "checkbox" {
+				return token, false
+			}
+		}
+	}
+
+	return token, true
+}
+
+func sanitizeAttrValue(tag string, key string, value string) (string, bool) {
+	switch key {
+	case "href":
+		return SafeURL(value)
+	case "src":
+		if tag == "iframe" {
+			return value, strings.HasPrefix(value, youtubeEmbedBase)
+		}
+		safe, ok := SafeURL(value)
+		return safe, ok && !strings.HasPrefix(strings.ToLower(safe), "mailto:") && !strings.HasPrefix(strings.ToLower(safe), "tel:")
+	case "style":
+		return value, sanitizeStylePattern.MatchString(strings.TrimSpace(value))
+	case "class":
+		return value, sanitizeClassPattern.MatchString(value)
+	case "target":
+		return value, value == "_blank"
+	}
+
+	return value, true
+}
+
+func hasAttr(attrs []html.Attribute, key string) bool {
+	for _, attr := range attrs {
+		if attr.Key == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+package utils
+
+import "strings"
+
+// TipTapImageSources editör JSON'ındaki görsel düğümlerinin (image, enhancedImage, instagramCarousel kartları)
+// src değerlerini tekrarsız olarak döndürür. JSON çözümlenemezse boş liste döner.
+func TipTapImageSources(jsonContent string) []string {
+	sources := []string{}
+
+	doc, err := ParseTipTapJSON(jsonContent)
+	if err != nil {
+		return sources
+	}
+
+	seen := map[string]bool{}
+	add := func(attrs map[string]any) {
+		src := strings.TrimSpace(tipTapString(attrs, "src"))
+		if src != "" && !seen[src] {
+			seen[src] = true
+			sources = append(sources, src)
+		}
+	}
+
+	walkTipTapNodes(*doc, func(node TipTapNode) {
+		switch node.Type {
+		case "image", "enhancedImage":
+			add(node.Attrs)
+
+		case "instagramCarousel":
+			if cards, ok := node.Attrs["cards"].([]any); ok {
+				for _, card := range cards {
+					if attrs, ok := card.(map[string]any); ok {
+						add(attrs)
+					}
+				}
+			}
+		}
+	})
+
+	return sources
+}
@@ -0,0 +1,139 @@
+package utils
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/okanay/backend-blog-guideofdubai/types"
+)
+
+// readingWordsPerMinute dillere göre ortalama okuma hızı (dakikada kelime)
+var readingWordsPerMinute = map[string]int{
+	"en": 230,
+	"tr": 190,
+	"de": 180,
+	"fr": 195,
+	"es": 220,
+	"it": 190,
+	"ru": 180,
+	"ar": 180,
+	"fa": 180,
+	"zh": 260, // Çince ve Japoncada her karakter bir kelime sayılır
+	"ja": 260,
+}
+
+const defaultWordsPerMinute = 200
+
+// AnalyzeTipTapContent editör JSON'ından kelime sayısını, dile göre okuma süresini (dakika)
+// ve başlıklardan oluşan içindekiler tablosunu üretir. Anchor ID'leri RenderTipTapHTML ile aynıdır.
+func AnalyzeTipTapContent(jsonContent string, language string) types.ContentStats {
+	stats := types.ContentStats{TOC: []types.TOCItem{}}
+
+	doc, err := ParseTipTapJSON(jsonContent)
+	if err != nil {
+		stats.ReadTime = 1
+		return stats
+	}
+
+	anchors := newTipTapAnchors()
+	walkTipTapNodes(*doc, func(node TipTapNode) {
+		switch node.Type {
+		case "text":
+			stats.WordCount += countWords(node.Text)
+		case "heading":
+			text := strings.TrimSpace(tipTapPlainText(node))
+			level := tipTapInt(node.Attrs, "level", 2)
+			if level < 1 || level > 6 {
+				level = 2
+			}
+			stats.TOC = append(stats.TOC, types.TOCItem{
+				ID:    anchors.next(text),
+				Text:  text,
+				Level: level,
+			})
+		}
+	})
+
+	stats.ReadTime = readTimeMinutes(stats.WordCount, language)
+	return stats
+}
+
+// readTimeMinutes kelime sayısından dakika cinsinden okuma süresini hesaplar (en az 1)
+func readTimeMinutes(wordCount int, language string) int {
+	wpm, ok := readingWordsPerMinute[strings.ToLower(strings.SplitN(language, "-", 2)[0])]
+	if !ok {
+		wpm = defaultWordsPerMinute
+	}
+
+	minutes := int(math.Ceil(float64(wordCount) / float64(wpm)))
+	if minutes < 1 {
+		return 1
+	}
+	return minutes
+}
+
+// countWords boşlukla ayrılan kelimeleri sayar; boşluk kullanılmayan yazı sistemlerinde
+// (Çince, Japonca) her karakter ayrı bir kelime kabul edilir
+func countWords(text string) int {
+	count := 0
+	inWord := false
+
+	for _, r := range text {
+		switch {
+		case unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana):
+			count++
+			inWord = false
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if !inWord {
+				count++
+				inWord = true
+			}
+		case r == '\'' || r == '’' || r == '-':
+			// Kesme işareti ve tire kelimeyi bölmez
+		default:
+			inWord = false
+		}
+	}
+
+	return count
+}
+
+// tipTapAnchors başlık metinlerinden tekil ve kararlı anchor ID'leri üretir
+type tipTapAnchors struct {
+	used map[string]bool
+}
+
+func newTipTapAnchors() *tipTapAnchors {
+	return &tipTapAnchors{used: map[string]bool{}}
+}
+
+// next başlık metnini anchor'a çevirir; aynı anchor tekrar ederse sonuna -2, -3 ... eklenir
+func (a *tipTapAnchors) next(text string) string {
+	var sb strings.Builder
+	lastHyphen := true
+
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			sb.WriteRune(r)
+			lastHyphen = false
+		case !lastHyphen:
+			sb.WriteRune('-')
+			lastHyphen = true
+		}
+	}
+
+	base := strings.TrimSuffix(sb.String(), "-")
+	if base == "" {
+		base = "section"
+	}
+
+	anchor := base
+	for n := 2; a.used[anchor]; n++ {
+		anchor = base + "-" + strconv.Itoa(n)
+	}
+	a.used[anchor] = true
+	return anchor
+}
@@ -0,0 +1,364 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+)
+
+// TipTapNode editörün JSON çıktısındaki tek bir düğüm
+type TipTapNode struct {
+	Type    string         `json:"type"`
+	Attrs   map[string]any `json:"attrs,omitempty"`
+	Content []TipTapNode   `json:"content,omitempty"`
+	Text    string         `json:"text,omitempty"`
+	Marks   []TipTapMark   `json:"marks,omitempty"`
+}
+
+// TipTapMark metin düğümüne uygulanan biçim (bold, link vb.)
+type TipTapMark struct {
+	Type  string         `json:"type"`
+	Attrs map[string]any `json:"attrs,omitempty"`
+}
+
+// ParseTipTapJSON editör JSON'ını düğüm ağacına çevirir
+func ParseTipTapJSON(jsonContent string) (*TipTapNode, error) {
+	var doc TipTapNode
+	if err := json.Unmarshal([]byte(jsonContent), &doc); err != nil {
+		return nil, fmt.Errorf("error parsing tiptap json: %w", err)
+	}
+
+	return &doc, nil
+}
+
+// RenderTipTapHTML editör JSON'ından HTML üretir; blog içeriğinin HTML'i için tek kaynak budur.
+// Metin ve öznitelikler escape edilir, bağlantılarda yalnızca güvenli URL şemalarına izin verilir.
+// Bilinmeyen düğümlerin yalnızca içeriği yazılır.
+func RenderTipTapHTML(jsonContent string) (string, error) {
+	doc, err := ParseTipTapJSON(jsonContent)
+	if err != nil {
+		return "", err
+	}
+
+	if doc.Type != "doc" {
+		return "", fmt.Errorf("error rendering tiptap json: root node must be doc, got %q", doc.Type)
+	}
+
+	renderer := tipTapRenderer{anchors: newTipTapAnchors()}
+	renderer.node(*doc)
+	return renderer.sb.String(), nil
+}
+
+// tipTapRenderer HTML çıktısını ve başlıklara verilen anchor ID'lerini tutar
+type tipTapRenderer struct {
+	sb      strings.Builder
+	anchors *tipTapAnchors
+}
+
+func (r *tipTapRenderer) node(node TipTapNode) {
+	sb := &r.sb
+	switch node.Type {
+	case "doc":
+		r.children(node)
+
+	case "text":
+		renderTipTapText(sb, node)
+
+	case "paragraph":
+		r.block("p", tipTapAlignAttr(node), node)
+
+	case "heading":
+		level := tipTapInt(node.Attrs, "level", 2)
+		if level < 1 || level > 6 {
+			level = 2
+		}
+		// Anchor ID'leri içindekiler tablosuyla (AnalyzeTipTapContent) aynı sırayla üretilir
+		attrs := ` id="` + html.EscapeString(r.anchors.next(tipTapPlainText(node))) + `"` + tipTapAlignAttr(node)
+		r.block("h"+strconv.Itoa(level), attrs, node)
+
+	case "blockquote":
+		r.block("blockquote", "", node)
+
+	case "bulletList":
+		r.block("ul", "", node)
+
+	case "orderedList":
+		attrs := ""
+		if start := tipTapInt(node.Attrs, "start", 1); start != 1 {
+			attrs = fmt.Sprintf(` start="%d"`, start)
+		}
+		r.block("ol", attrs, node)
+
+	case "listItem":
+		r.block("li", "", node)
+
+	case "codeBlock":
+		attrs := ""
+		if language := tipTapString(node.Attrs, "language"); language != "" {
+			attrs = ` class="language-` + html.EscapeString(language) + `"`
+		}
+		sb.WriteString("<pre><code" + attrs + ">")
+		for _, child := range node.Content {
+			sb.WriteString(html.EscapeString(child.Text))
+		}
+		sb.WriteString("</code></pre>")
+
+	case "hardBreak":
+		sb.WriteString("<br>")
+
+	case "horizontalRule":
+		sb.WriteString("<hr>")
+
+	case "image":
+		renderTipTapImage(sb, node.Attrs)
+
+	case "enhancedImage":
+		renderTipTapFigure(sb, node.Attrs)
+
+	case "taskList":
+		r.block("ul", ` data-type="taskList"`, node)
+
+	case "taskItem":
+		checked := ""
+		if value, ok := node.Attrs["checked"].(bool); ok && value {
+			checked = " checked"
+		}
+		sb.WriteString(`<li data-type="taskItem"><input type="checkbox" disabled` + checked + `><div>`)
+		r.children(node)
+		sb.WriteString("</div></li>")
+
+	case "table":
+		sb.WriteString("<table><tbody>")
+		r.children(node)
+		sb.WriteString("</tbody></table>")
+
+	case "tableRow":
+		r.block("tr", "", node)
+
+	case "tableCell", "tableHeader":
+		tag := "td"
+		if node.Type == "tableHeader" {
+			tag = "th"
+		}
+		attrs := ""
+		for _, key := range []string{"colspan", "rowspan"} {
+			if value := tipTapInt(node.Attrs, key, 1); value > 1 {
+				attrs += fmt.Sprintf(` %s="%d"`, key, value)
+			}
+		}
+		r.block(tag, attrs, node)
+
+	case "youtube":
+		renderTipTapYoutube(sb, node.Attrs)
+
+	case "instagramCarousel":
+		sb.WriteString(`<div class="instagram-carousel">`)
+		if cards, ok := node.Attrs["cards"].([]any); ok {
+			for _, card := range cards {
+				if attrs, ok := card.(map[string]any); ok {
+					renderTipTapFigure(sb, attrs)
+				}
+			}
+		}
+		sb.WriteString("</div>")
+
+	default:
+		r.children(node)
+	}
+}
+
+func (r *tipTapRenderer) children(node TipTapNode) {
+	for _, child := range node.Content {
+		r.node(child)
+	}
+}
+
+func (r *tipTapRenderer) block(tag string, attrs string, node TipTapNode) {
+	r.sb.WriteString("<" + tag + attrs + ">")
+	r.children(node)
+	r.sb.WriteString("</" + tag + ">")
+}
+
+func renderTipTapText(sb *strings.Builder, node TipTapNode) {
+	open := strings.Builder{}
+	closing := []string{}
+
+	for _, mark := range node.Marks {
+		tag, attrs := tipTapMarkTag(mark)
+		if tag == "" {
+			continue
+		}
+		open.WriteString("<" + tag + attrs + ">")
+		closing = append(closing, "</"+tag+">")
+	}
+
+	sb.WriteString(open.String())
+	sb.WriteString(html.EscapeString(node.Text))
+	for i := len(closing) - 1; i >= 0; i-- {
+		sb.WriteString(closing[i])
+	}
+}
+
+func tipTapMarkTag(mark TipTapMark) (string, string) {
+	switch mark.Type {
+	case "bold":
+		return "strong", ""
+	case "italic":
+		return "em", ""
+	case "underline":
+		return "u", ""
+	case "strike":
+		return "s", ""
+	case "code":
+		return "code", ""
+	case "highlight":
+		return "mark", ""
+	case "subscript":
+		return "sub", ""
+	case "superscript":
+		return "sup", ""
+	case "link":
+		href, ok := SafeURL(tipTapString(mark.Attrs, "href"))
+		if !ok {
+			return "", ""
+		}
+		attrs := ` href="` + html.EscapeString(href) + `"`
+		if target := tipTapString(mark.Attrs, "target"); target == "_blank" {
+			attrs += ` target="_blank" rel="noopener noreferrer nofollow"`
+		}
+		return "a", attrs
+	}
+
+	return "", ""
+}
+
+func renderTipTapImage(sb *strings.Builder, attrs map[string]any) {
+	src, ok := SafeURL(tipTapString(attrs, "src"))
+	if !ok || src == "" {
+		return
+	}
+
+	sb.WriteString(`<img src="` + html.EscapeString(src) + `"`)
+	sb.WriteString(` alt="` + html.EscapeString(tipTapString(attrs, "alt")) + `"`)
+	if title := tipTapString(attrs, "title"); title != "" {
+		sb.WriteString(` title="` + html.EscapeString(title) + `"`)
+	}
+	for _, key := range []string{"width", "height"} {
+		if value := tipTapInt(attrs, key, 0); value > 0 {
+			sb.WriteString(fmt.Sprintf(` %s="%d"`, key, value))
+		}
+	}
+	sb.WriteString(` loading="lazy">`)
+}
+
+// renderTipTapYoutube yalnızca YouTube video ID'si çıkarılabilen kaynakları nocookie embed olarak yazar
+func renderTipTapYoutube(sb *strings.Builder, attrs map[string]any) {
+	videoID := youtubeVideoID(tipTapString(attrs, "src"))
+	if videoID == "" {
+		return
+	}
+
+	sb.WriteString(`<div data-youtube-video><iframe src="https://www.youtube-nocookie.com/embed/` + videoID + `"`)
+	for _, key := range []string{"width", "height"} {
+		if value := tipTapInt(attrs, key, 0); value > 0 {
+			sb.WriteString(fmt.Sprintf(` %s="%d"`, key, value))
+		}
+	}
+	sb.WriteString(` frameborder="0" allowfullscreen loading="lazy"></iframe></div>`)
+}
+
+func youtubeVideoID(src string) string {
+	src = strings.TrimSpace(src)
+	for _, prefix := range []string{"youtu.be/", "/embed/", "/shorts/", "v="} {
+		index := strings.Index(src, prefix)
+		if index == -1 {
+			continue
+		}
+
+		id := src[index+len(prefix):]
+		if end := strings.IndexAny(id, "?&#/"); end != -1 {
+			id = id[:end]
+		}
+
+		for _, r := range id {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+				return ""
+			}
+		}
+		return id
+	}
+
+	return ""
+}
+
+func renderTipTapFigure(sb *strings.Builder, attrs map[string]any) {
+	sb.WriteString("<figure>")
+	renderTipTapImage(sb, attrs)
+	if caption := tipTapString(attrs, "caption"); caption != "" {
+		sb.WriteString("<figcaption>" + html.EscapeString(caption) + "</figcaption>")
+	}
+	sb.WriteString("</figure>")
+}
+
+func tipTapAlignAttr(node TipTapNode) string {
+	switch align := tipTapString(node.Attrs, "textAlign"); align {
+	case "center", "right", "justify":
+		return ` style="text-align: ` + align + `"`
+	}
+	return ""
+}
+
+func tipTapString(attrs map[string]any, key string) string {
+	if value, ok := attrs[key].(string); ok {
+		return value
+	}
+	return ""
+}
+
+func tipTapInt(attrs map[string]any, key string, fallback int) int {
+	switch value := attrs[key].(type) {
+	case float64:
+		return int(value)
+	case string:
+		if parsed, err := strconv.Atoi(value); err == nil {
+			return parsed
+		}
+	}
+	return fallback
+}
+
+// SafeURL yalnızca http, https, mailto, tel şemalı veya göreli URL'lere izin verir
+func SafeURL(raw string) (string, bool) {
+	value := strings.TrimSpace(raw)
+	if value == "" {
+		return "", true
+	}
+
+	lower := strings.ToLower(value)
+	// Kontrol karakterleri ve boşluklar şema gizlemek için kullanılabilir ("java\tscript:")
+	lower = strings.Map(func(r rune) rune {
+		if r <= ' ' {
+			return -1
+		}
+		return r
+	}, lower)
+
+	colon := strings.Index(lower, ":")
+	if colon == -1 {
+		return value, true
+	}
+
+	// İlk ":" bir path, query veya fragment içindeyse URL görelidir
+	if slash := strings.IndexAny(lower, "/?#"); slash != -1 && slash < colon {
+		return value, true
+	}
+
+	switch lower[:colon] {
+	case "http", "https", "mailto", "tel":
+		return value, true
+	}
+
+	return "", false
+}
@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update", false, "testdata/tiptap altındaki .golden dosyalarını yeniden yazar")
+
+// TestRenderTipTapHTML testdata/tiptap/*.json girdilerini render edip aynı isimli .golden çıktılarıyla karşılaştırır.
+// Render hatası veren girdilerde golden dosyası "error: ..." satırını içerir.
+func TestRenderTipTapHTML(t *testing.T) {
+	inputs, err := filepath.Glob(filepath.Join("testdata", "tiptap", "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(inputs) == 0 {
+		t.Fatal("no tiptap fixtures found")
+	}
+
+	for _, input := range inputs {
+		name := strings.TrimSuffix(filepath.Base(input), ".json")
+		t.Run(name, func(t *testing.T) {
+			data, err := os.ReadFile(input)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := RenderTipTapHTML(string(data))
+			if err != nil {
+				got = "error: " + err.Error()
+			}
+			got += "\n"
+
+			golden := strings.TrimSuffix(input, ".json") + ".golden"
+			if *updateGolden {
+				if err := os.WriteFile(golden, []byte(got), 0o644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("missing golden file (run go test -update): %v", err)
+			}
+			if got != string(want) {
+				t.Errorf("RenderTipTapHTML() mismatch\n got: %s\nwant: %s", got, want)
+			}
+		})
+	}
+}
+
+func TestSafeURL(t *testing.T) {
+	tests := []struct {
+		raw    string
+		want   string
+		wantOK bool
+	}{
+		{"", "", true},
+		{"https://example.com", "https://example.com", true},
+		{" http://example.com ", "http://example.com", true},
+		{"mailto:info@example.com", "mailto:info@example.com", true},
+		{"tel:+971000000", "tel:+971000000", true},
+		{"/blog/dubai", "/blog/dubai", true},
+		{"#section", "#section", true},
+		{"/path:with-colon", "/path:with-colon", true},
+		{"?q=a:b", "?q=a:b", true},
+		{"javascript:alert(1)", "", false},
+		{"JavaScript:alert(1)", "", false},
+		{"java\tscript:alert(1)", "", false},
+		{"\x01javascript:alert(1)", "", false},
+		{"data:text/html;base64,PHNjcmlwdD4=", "", false},
+		{"vbscript:msgbox(1)", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got, ok := SafeURL(tt.raw)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("SafeURL(%q) = (%q, %v), want (%q, %v)", tt.raw, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -1,16 +1,29 @@
 package utils
 
 import (
+	"errors"
 	"net/http"
+	"reflect"
 	"strings"
+	"sync"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
 )
 
-// ValidateRequest, request verilerinin doğruluğunu kontrol eder ve hataları işler
+// ValidateRequest, request verilerinin doğruluğunu kontrol eder ve hataları işler.
+// Doğrulama hataları alan bazında "fields" listesi olarak döner.
 func ValidateRequest(c *gin.Context, req any) error {
+	configureValidator()
+
 	if err := c.ShouldBindJSON(&req); err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			respondValidationErrors(c, validationErrors)
+			return err
+		}
+
 		// JSON ayrıştırma hatası
 		c.JSON(http.StatusBadRequest, ErrorResponse{
 			Success: false,
@@ -24,39 +37,113 @@ func ValidateRequest(c *gin.Context, req any) error {
 	err := validate.Struct(req)
 
 	if err != nil {
-		var errorMessages []string
-
-		// Doğrulama hatalarını al
-		validationErrors := err.(validator.ValidationErrors)
-		for _, e := range validationErrors {
-			// Daha kullanıcı dostu hata mesajları hazırla
-			field := e.Field()
-			switch e.Tag() {
-			case "required":
-				errorMessages = append(errorMessages, field+" alanı zorunludur")
-			case "email":
-				errorMessages = append(errorMessages, field+" alanı geçerli bir e-posta adresi olmalıdır")
-			case "min":
-				errorMessages = append(errorMessages, field+" alanı en az "+e.Param()+" karakter olmalıdır")
-			case "max":
-				errorMessages = append(errorMessages, field+" alanı en fazla "+e.Param()+" karakter olmalıdır")
-			default:
-				errorMessages = append(errorMessages, field+" alanı geçersiz: "+e.Tag())
-			}
+		respondValidationErrors(c, err.(validator.ValidationErrors))
+		return err
+	}
+
+	return nil
+}
+
+// ValidateInput sunucuda oluşturulan girdileri (ör. revizyon geri yükleme, AI çevirisi) istek gövdeleriyle aynı kurallara göre doğrular
+func ValidateInput(input any) error {
+	configureValidator()
+	return binding.Validator.ValidateStruct(input)
+}
+
+// RespondValidationError ValidateInput hatasını istek doğrulamasıyla aynı biçimde (alan bazında) yazar
+func RespondValidationError(c *gin.Context, err error) {
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		respondValidationErrors(c, validationErrors)
+		return
+	}
+
+	c.JSON(http.StatusBadRequest, ErrorResponse{
+		Success: false,
+		Error:   "validation_error",
+		Message: err.Error(),
+	})
+}
+
+var validatorOnce sync.Once
+
+// configureValidator gin'in binding doğrulayıcısına JSON alan adlarını ve içerik kurallarını bir kez tanıtır
+func configureValidator() {
+	validatorOnce.Do(func() {
+		engine, ok := binding.Validator.Engine().(*validator.Validate)
+		if !ok {
+			return
 		}
 
-		// Tüm hata mesajlarını birleştir
-		message := strings.Join(errorMessages, ", ")
+		engine.RegisterTagNameFunc(func(field reflect.StructField) string {
+			name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+			if name == "" || name == "-" {
+				return field.Name
+			}
+			return name
+		})
 
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Success: false,
-			Error:   "validation_error",
+		registerContentValidations(engine)
+	})
+}
+
+func respondValidationErrors(c *gin.Context, validationErrors validator.ValidationErrors) {
+	var errorMessages []string
+	fields := make([]FieldError, 0, len(validationErrors))
+
+	for _, e := range validationErrors {
+		message := validationMessage(e)
+		errorMessages = append(errorMessages, message)
+		fields = append(fields, FieldError{
+			Field:   validationFieldPath(e),
+			Rule:    e.Tag(),
 			Message: message,
 		})
-		return err
 	}
 
-	return nil
+	// Tüm hata mesajlarını birleştir
+	c.JSON(http.StatusBadRequest, ErrorResponse{
+		Success: false,
+		Error:   "validation_error",
+		Message: strings.Join(errorMessages, ", "),
+		Fields:  fields,
+	})
+}
+
+// validationFieldPath istek kökünden itibaren alan yolunu döndürür (ör. "content.json")
+func validationFieldPath(e validator.FieldError) string {
+	namespace := e.Namespace()
+	if index := strings.Index(namespace, "."); index != -1 {
+		return namespace[index+1:]
+	}
+	return namespace
+}
+
+// validationMessage daha kullanıcı dostu hata mesajları hazırlar
+func validationMessage(e validator.FieldError) string {
+	field := e.Field()
+	switch e.Tag() {
+	case "required":
+		return field + " alanı zorunludur"
+	case "email":
+		return field + " alanı geçerli bir e-posta adresi olmalıdır"
+	case "min":
+		return field + " alanı en az " + e.Param() + " karakter olmalıdır"
+	case "max":
+		return field + " alanı en fazla " + e.Param() + " karakter olmalıdır"
+	case contentRuleMaxSize:
+		return "İçerik en fazla " + e.Param() + " bayt olabilir"
+	case contentRuleDocument:
+		return "İçerik JSON'ı geçerli bir editör dokümanı değil"
+	case contentRuleImageAlt:
+		return "Görsel için alt metin zorunludur: " + e.Param()
+	case contentRuleImageSource:
+		return "Görseller yalnızca R2 depolamasından kullanılabilir: " + e.Param()
+	case contentRuleEmptyHeading:
+		return e.Param() + ". başlık boş olamaz"
+	default:
+		return field + " alanı geçersiz: " + e.Tag()
+	}
 }
 
 // ValidateParam, URL parametrelerini doğrular